@@ -55,6 +55,17 @@ func ObserveAdmissionPlugins(genericListers configobserver.Listers, recorder eve
 		return existingConfig, []error{fmt.Errorf("plugins cannot be enabled and disabled at the same time: %v", intersection.UnsortedList())}
 	}
 
+	// record which plugins changed state, as disabling or re-enabling a plugin can
+	// materially change the authorization behavior of the cluster
+	existingDisabled, _, _ := unstructured.NestedStringSlice(existingConfig, disableAdmissionPluginsPath...)
+	existingDisabledSet := sets.New(existingDisabled...)
+	if newlyDisabled := disabledSet.Difference(existingDisabledSet); newlyDisabled.Len() > 0 {
+		recorder.Eventf("ObserveAdmissionPlugins", "Disabled admission plugins: %v", sets.List(newlyDisabled))
+	}
+	if reEnabled := enabledSet.Intersection(existingDisabledSet); reEnabled.Len() > 0 {
+		recorder.Eventf("ObserveAdmissionPlugins", "Re-enabled previously disabled admission plugins: %v", sets.List(reEnabled))
+	}
+
 	observedConfig := map[string]any{}
 
 	if enabledSet.Len() > 0 {
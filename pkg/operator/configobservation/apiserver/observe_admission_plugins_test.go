@@ -1,6 +1,8 @@
 package apiserver
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"testing"
 	"time"
@@ -26,6 +28,7 @@ func TestObserveAdmissionPlugins(t *testing.T) {
 
 		expectErrors   bool
 		expectedConfig map[string]any
+		expectedEvents []string
 	}{
 		{
 			name:           "no plugin checkers available",
@@ -84,6 +87,9 @@ func TestObserveAdmissionPlugins(t *testing.T) {
 					"disable-admission-plugins": []any{"disabled1", "disabled2"},
 				},
 			},
+			expectedEvents: []string{
+				"Disabled admission plugins: [disabled1 disabled2]",
+			},
 		},
 		{
 			name: "plugin checkers must overwrite existing enabled and disabled",
@@ -112,6 +118,51 @@ func TestObserveAdmissionPlugins(t *testing.T) {
 					"disable-admission-plugins": []any{"disabled1", "disabled2"},
 				},
 			},
+			expectedEvents: []string{
+				"Disabled admission plugins: [disabled1 disabled2]",
+			},
+		},
+		{
+			name: "already disabled plugins must not be reported",
+			existingConfig: map[string]any{
+				"apiServerArguments": map[string]any{
+					"disable-admission-plugins": []any{"disabled1"},
+				},
+			},
+			pluginCheckers: []pluginCheckerFunc{
+				func(_ configobservation.Listers) ([]string, []string, error) {
+					return []string{"enabled1"}, []string{"disabled1"}, nil
+				},
+			},
+			expectErrors: false,
+			expectedConfig: map[string]any{
+				"apiServerArguments": map[string]any{
+					"enable-admission-plugins":  []any{"enabled1"},
+					"disable-admission-plugins": []any{"disabled1"},
+				},
+			},
+		},
+		{
+			name: "re-enabled plugins must be reported",
+			existingConfig: map[string]any{
+				"apiServerArguments": map[string]any{
+					"disable-admission-plugins": []any{"plugin1", "plugin2"},
+				},
+			},
+			pluginCheckers: []pluginCheckerFunc{
+				func(_ configobservation.Listers) ([]string, []string, error) {
+					return []string{"plugin1", "plugin2"}, nil, nil
+				},
+			},
+			expectErrors: false,
+			expectedConfig: map[string]any{
+				"apiServerArguments": map[string]any{
+					"enable-admission-plugins": []any{"plugin1", "plugin2"},
+				},
+			},
+			expectedEvents: []string{
+				"Re-enabled previously disabled admission plugins: [plugin1 plugin2]",
+			},
 		},
 		{
 			name: "plugin checkers must return disjoint enabled and disabled plugin slices",
@@ -140,10 +191,55 @@ func TestObserveAdmissionPlugins(t *testing.T) {
 			if !equality.Semantic.DeepEqual(tt.expectedConfig, gotConfig) {
 				t.Errorf("unexpected config diff: %s", diff.Diff(tt.expectedConfig, gotConfig))
 			}
+
+			gotEvents := []string{}
+			for _, event := range eventRecorder.Events() {
+				gotEvents = append(gotEvents, event.Message)
+			}
+			if !equality.Semantic.DeepEqual(tt.expectedEvents, gotEvents) {
+				t.Errorf("unexpected events diff: %s", diff.Diff(tt.expectedEvents, gotEvents))
+			}
 		})
 	}
 }
 
+func TestObserveAdmissionPluginsStableOutput(t *testing.T) {
+	pluginCheckers = []pluginCheckerFunc{
+		func(_ configobservation.Listers) ([]string, []string, error) {
+			return []string{"enabled2", "enabled1"}, []string{"disabled2"}, nil
+		},
+		func(_ configobservation.Listers) ([]string, []string, error) {
+			return []string{"enabled3"}, []string{"disabled1"}, nil
+		},
+	}
+
+	eventRecorder := events.NewInMemoryRecorder("TestObserveAdmissionPluginsStableOutput", clocktesting.NewFakePassiveClock(time.Now()))
+	listers := configobservation.Listers{}
+
+	firstConfig, errs := ObserveAdmissionPlugins(listers, eventRecorder, map[string]any{})
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+
+	secondConfig, errs := ObserveAdmissionPlugins(listers, eventRecorder, firstConfig)
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+
+	first, err := json.Marshal(firstConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := json.Marshal(secondConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(first, second) {
+		t.Errorf("expected identical output across observations; got %s and %s", first, second)
+	}
+}
+
 func TestRoleBindingRestrictionPluginChecker(t *testing.T) {
 	for _, tt := range []struct {
 		name             string
@@ -21,10 +21,16 @@ import (
 	"github.com/openshift/cluster-kube-apiserver-operator/pkg/operator/configobservation"
 )
 
+const (
+	serviceAccountIssuerArg = "service-account-issuer"
+	audiencesArg            = "api-audiences"
+	jwksURIArg              = "service-account-jwks-uri"
+)
+
 var (
-	serviceAccountIssuerPath = []string{"apiServerArguments", "service-account-issuer"}
-	audiencesPath            = []string{"apiServerArguments", "api-audiences"}
-	jwksURIPath              = []string{"apiServerArguments", "service-account-jwks-uri"}
+	serviceAccountIssuerPath = []string{"apiServerArguments", serviceAccountIssuerArg}
+	audiencesPath            = []string{"apiServerArguments", audiencesArg}
+	jwksURIPath              = []string{"apiServerArguments", jwksURIArg}
 )
 
 // defaultServiceAccountIssuerValue is a value used when no service account issuer is configured.
@@ -50,7 +56,7 @@ func ObserveServiceAccountIssuer(
 // Authentication resource.
 func observedConfig(existingConfig map[string]interface{},
 	getOperator func(name string) (*operatorv1.KubeAPIServer, error),
-	getInfrastructureConfig func(string) (*configv1.Infrastructure, error), recorder events.Recorder) (map[string]interface{}, []error) {
+	getInfrastructureConfig func(string) (*configv1.Infrastructure, error), recorder events.Recorder) (ret map[string]interface{}, _ []error) {
 
 	errs := []error{}
 	var issuerChanged bool
@@ -61,8 +67,9 @@ func observedConfig(existingConfig map[string]interface{},
 		if issuerChanged {
 			recorder.Eventf(
 				"ObserveServiceAccountIssuer",
-				"ServiceAccount issuer changed from %v to %v",
+				"ServiceAccount issuer changed from %v to %v; changed arguments: %v",
 				existingConfigIssuer, observedActiveIssuer,
+				configobservation.DiffAPIServerArguments(existingConfig, ret, []string{serviceAccountIssuerArg, audiencesArg, jwksURIArg}),
 			)
 		}
 	}()
@@ -121,8 +128,8 @@ func observedConfig(existingConfig map[string]interface{},
 		apiServerArgumentValue = append(apiServerArgumentValue, desiredTrustedIssuers[i])
 	}
 	apiServerArguments := map[string]interface{}{
-		"service-account-issuer": apiServerArgumentValue,
-		"api-audiences":          apiServerArgumentValue,
+		serviceAccountIssuerArg: apiServerArgumentValue,
+		audiencesArg:            apiServerArgumentValue,
 	}
 
 	// If the issuer is not set in KAS, we rely on config-overrides.yaml to provide both
@@ -167,7 +174,7 @@ func observedConfig(existingConfig map[string]interface{},
 			return existingConfig, append(errs, fmt.Errorf("APIServerURL missing from infrastructure/cluster"))
 		}
 
-		apiServerArguments["service-account-jwks-uri"] = []interface{}{
+		apiServerArguments[jwksURIArg] = []interface{}{
 			apiServerExternalURL + "/openid/v1/jwks",
 		}
 	} else {
@@ -188,7 +195,7 @@ func observedConfig(existingConfig map[string]interface{},
 				"It is highly recommended that the issuer be a valid HTTPS URL per the OpenID Discovery spec.",
 				observedActiveIssuer, parsed.Scheme)
 		default:
-			apiServerArguments["service-account-jwks-uri"] = []interface{}{
+			apiServerArguments[jwksURIArg] = []interface{}{
 				observedActiveIssuer + "/openid/v1/jwks",
 			}
 		}
@@ -37,6 +37,7 @@ func TestObservedConfig(t *testing.T) {
 		expectedIssuer         string
 		expectedTrustedIssuers []string
 		expectedChange         bool
+		expectedEvent          string
 		expectedJWKSURI        string
 	}{
 		{
@@ -64,6 +65,7 @@ func TestObservedConfig(t *testing.T) {
 			expectedIssuer:  "https://example.com",
 			expectedJWKSURI: "https://example.com/openid/v1/jwks",
 			expectedChange:  true,
+			expectedEvent:   "ServiceAccount issuer changed from https://kubernetes.default.svc to https://example.com; changed arguments: [service-account-issuer api-audiences service-account-jwks-uri]",
 		},
 		{
 			name:                   "previous issuer was default, new is custom value",
@@ -196,6 +198,9 @@ func TestObservedConfig(t *testing.T) {
 			// Deep comparison of the entire configuration handles the JWKS URI check automatically.
 			require.Equal(t, expectedConfig, unmarshalledConfig, cmp.Diff(expectedConfig, unmarshalledConfig))
 			require.True(t, tc.expectedChange == (len(testRecorder.Events()) > 0))
+			if len(tc.expectedEvent) > 0 {
+				require.Equal(t, tc.expectedEvent, testRecorder.Events()[0].Message)
+			}
 		})
 	}
 }
@@ -1,22 +1,33 @@
 package auth
 
 import (
+	"encoding/json"
 	"fmt"
 	"path"
+	"strings"
+	"sync"
 
 	configv1 "github.com/openshift/api/config/v1"
 	"github.com/openshift/api/features"
 	"github.com/openshift/cluster-kube-apiserver-operator/pkg/operator/configobservation"
-	"github.com/openshift/cluster-kube-apiserver-operator/pkg/operator/operatorclient"
 	"github.com/openshift/library-go/pkg/operator/configobserver"
 	"github.com/openshift/library-go/pkg/operator/configobserver/featuregates"
 	"github.com/openshift/library-go/pkg/operator/events"
 	"github.com/openshift/library-go/pkg/operator/resourcesynccontroller"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/apis/apiserver"
+	"k8s.io/apiserver/pkg/apis/apiserver/install"
+	"k8s.io/apiserver/pkg/apis/apiserver/validation"
+	authenticationcel "k8s.io/apiserver/pkg/authentication/cel"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
 )
 
 const (
@@ -27,14 +38,90 @@ const (
 
 var authConfigPath = []string{"apiServerArguments", "authentication-config"}
 
-func NewObserveExternalOIDC(featureGateAccessor featuregates.FeatureGateAccess) configobserver.ObserveConfigFunc {
-	return (&externalOIDC{
+var (
+	authConfigScheme = runtime.NewScheme()
+	authConfigCodecs = serializer.NewCodecFactory(authConfigScheme)
+)
+
+func init() {
+	install.Install(authConfigScheme)
+}
+
+// authConfigCELCompiler compiles the CEL expressions of the structured auth config
+// during validation; building its environments is expensive, so it's shared
+var authConfigCELCompiler = sync.OnceValue(authenticationcel.NewDefaultCompiler)
+
+// defaultExpectedOIDCClients lists the components that should have an OIDC client
+// configured before switching to OIDC; without a console client, admins get locked
+// out of the web console even though API access via tokens keeps working.
+var defaultExpectedOIDCClients = []oidcComponent{
+	{name: "console", namespace: "openshift-console", capability: configv1.ClusterVersionCapabilityConsole},
+}
+
+type oidcComponent struct {
+	name      string
+	namespace string
+
+	// capability is the optional cluster capability that provides the component;
+	// when set, the component is only expected while the capability is enabled
+	capability configv1.ClusterVersionCapability
+}
+
+func (c oidcComponent) String() string {
+	return fmt.Sprintf("%s/%s", c.namespace, c.name)
+}
+
+// NewObserveExternalOIDC returns an observer that configures the structured auth
+// config synced into targetNamespace, the namespace of the kube-apiserver pods.
+func NewObserveExternalOIDC(featureGateAccessor featuregates.FeatureGateAccess, targetNamespace string, clock clock.PassiveClock, options ...externalOIDCOptionFunc) configobserver.ObserveConfigFunc {
+	o := &externalOIDC{
 		featureGateAccessor: featureGateAccessor,
-	}).ObserveExternalOIDC
+		clock:               clock,
+		targetNamespace:     targetNamespace,
+		expectedOIDCClients: defaultExpectedOIDCClients,
+	}
+	for _, option := range options {
+		option(o)
+	}
+	return o.ObserveExternalOIDC
+}
+
+// externalOIDCOptionFunc customizes externalOIDC
+type externalOIDCOptionFunc func(o *externalOIDC)
+
+// WithRequiredOIDCClient requires the given component to have an OIDC client
+// configured before OIDC gets configured; no component is required by default
+func WithRequiredOIDCClient(namespace, name string) externalOIDCOptionFunc {
+	return func(o *externalOIDC) {
+		o.requiredOIDCClients = append(o.requiredOIDCClients, oidcComponent{name: name, namespace: namespace})
+	}
 }
 
 type externalOIDC struct {
 	featureGateAccessor featuregates.FeatureGateAccess
+	clock               clock.PassiveClock
+
+	// targetNamespace is the namespace the auth config is synced into
+	targetNamespace string
+
+	// requiredOIDCClients are the components that must have an OIDC client configured;
+	// OIDC is not configured until they do, but an existing OIDC config is kept
+	requiredOIDCClients []oidcComponent
+
+	// expectedOIDCClients are the components for which a warning is recorded when
+	// no OIDC client is configured; the config is rolled out regardless
+	expectedOIDCClients []oidcComponent
+
+	// lastFeatureGates holds the last successfully read feature gates; they are
+	// reused when reading the current ones fails transiently
+	lastFeatureGatesLock sync.Mutex
+	lastFeatureGates     featuregates.FeatureGate
+
+	// lastEvents holds the events recorded during the last observation, so that
+	// an event that persists across resyncs, or is observed again after a restart
+	// of the observation loop, is recorded only once
+	lastEventsLock sync.Mutex
+	lastEvents     map[string]string
 }
 
 // ObserveExternalOIDC observes the authentication.config/cluster resource
@@ -43,8 +130,23 @@ type externalOIDC struct {
 // takes care of synchronizing the structured auth config file into the apiserver's namespace
 // so that it gets mounted as a static file on each node.
 func (o *externalOIDC) ObserveExternalOIDC(genericListers configobserver.Listers, recorder events.Recorder, existingConfig map[string]interface{}) (ret map[string]interface{}, _ []error) {
+	o.lastEventsLock.Lock()
+	defer o.lastEventsLock.Unlock()
+
+	dedupRecorder := &dedupingRecorder{Recorder: recorder, previous: o.lastEvents}
+	recorder = dedupRecorder
+	defer func() {
+		o.lastEvents = dedupRecorder.current
+	}()
+
 	defer func() {
 		ret = configobserver.Pruned(ret, authConfigPath)
+
+		if authConfig, _, _ := unstructured.NestedStringSlice(ret, authConfigPath...); len(authConfig) > 0 {
+			externalOIDCEnabledGauge.Set(1)
+		} else {
+			externalOIDCEnabledGauge.Set(0)
+		}
 	}()
 
 	if !o.featureGateAccessor.AreInitialFeatureGatesObserved() {
@@ -52,13 +154,30 @@ func (o *externalOIDC) ObserveExternalOIDC(genericListers configobserver.Listers
 		return existingConfig, nil
 	}
 
-	featureGates, err := o.featureGateAccessor.CurrentFeatureGates()
+	featureGates, err := o.currentFeatureGates()
 	if err != nil {
 		return existingConfig, []error{err}
 	}
 
 	if !featureGates.Enabled(features.FeatureGateExternalOIDC) {
-		return existingConfig, nil
+		if authConfig, _, _ := unstructured.NestedStringSlice(existingConfig, authConfigPath...); len(authConfig) == 0 {
+			return existingConfig, nil
+		}
+
+		// the feature gate got disabled after OIDC had been configured; tear the config
+		// down so that the apiserver doesn't keep pointing to a stale auth config
+		listers := genericListers.(configobservation.Listers)
+		targetAuthConfig, err := listers.ConfigMapLister().ConfigMaps(o.targetNamespace).Get(AuthConfigCMName)
+		if err != nil && !errors.IsNotFound(err) {
+			return existingConfig, []error{err}
+		}
+
+		if errs := o.removeAuthConfig(genericListers, recorder, targetAuthConfig); len(errs) > 0 {
+			return existingConfig, errs
+		}
+
+		recorder.Eventf("ObserveExternalOIDC", "%s feature gate disabled; removed OIDC configuration", features.FeatureGateExternalOIDC)
+		return nil, nil
 	}
 
 	// When the ExternalOIDCExternalClaimsSourcing feature gate is enabled, the kube-apiserver
@@ -71,21 +190,13 @@ func (o *externalOIDC) ObserveExternalOIDC(genericListers configobserver.Listers
 		// In the event the older approach of the external OIDC configuration has been used,
 		// lets clean it up so that we don't end up with competing behaviors.
 		listers := genericListers.(configobservation.Listers)
-		targetAuthConfig, err := listers.ConfigMapLister().ConfigMaps(operatorclient.TargetNamespace).Get(AuthConfigCMName)
+		targetAuthConfig, err := listers.ConfigMapLister().ConfigMaps(o.targetNamespace).Get(AuthConfigCMName)
 		if err != nil && !errors.IsNotFound(err) {
 			return existingConfig, []error{err}
 		}
 
-		// empty source name/namespace effectively deletes target configmap
-		if err := genericListers.ResourceSyncer().SyncConfigMap(
-			resourcesynccontroller.ResourceLocation{Namespace: operatorclient.TargetNamespace, Name: AuthConfigCMName},
-			resourcesynccontroller.ResourceLocation{Namespace: "", Name: ""},
-		); err != nil {
-			return existingConfig, []error{err}
-		}
-
-		if targetAuthConfig != nil {
-			recorder.Eventf("ObserveExternalOIDC", "OIDC auth configmap %s/%s exists; requested deletion", operatorclient.TargetNamespace, AuthConfigCMName)
+		if errs := o.removeAuthConfig(genericListers, recorder, targetAuthConfig); len(errs) > 0 {
+			return existingConfig, errs
 		}
 
 		return nil, nil
@@ -95,28 +206,20 @@ func (o *externalOIDC) ObserveExternalOIDC(genericListers configobserver.Listers
 	auth, err := listers.AuthConfigLister.Get("cluster")
 	if errors.IsNotFound(err) {
 		recorder.Eventf("ObserveExternalOIDC", "authentications.config.openshift.io/cluster: not found")
-		klog.Warningf("authentications.config.openshift.io/cluster: not found")
+		klog.InfoS("authentications.config.openshift.io/cluster: not found", "authentication", klog.KRef("", "cluster"))
 		return existingConfig, nil
 	} else if err != nil {
 		return existingConfig, []error{err}
 	}
 
-	targetAuthConfig, err := listers.ConfigMapLister().ConfigMaps(operatorclient.TargetNamespace).Get(AuthConfigCMName)
+	targetAuthConfig, err := listers.ConfigMapLister().ConfigMaps(o.targetNamespace).Get(AuthConfigCMName)
 	if err != nil && !errors.IsNotFound(err) {
 		return existingConfig, []error{err}
 	}
 
 	if auth.Spec.Type != configv1.AuthenticationTypeOIDC {
-		// empty source name/namespace effectively deletes target configmap
-		if err := genericListers.ResourceSyncer().SyncConfigMap(
-			resourcesynccontroller.ResourceLocation{Namespace: operatorclient.TargetNamespace, Name: AuthConfigCMName},
-			resourcesynccontroller.ResourceLocation{Namespace: "", Name: ""},
-		); err != nil {
-			return existingConfig, []error{err}
-		}
-
-		if targetAuthConfig != nil {
-			recorder.Eventf("ObserveExternalOIDC", "OIDC auth configmap %s/%s exists; requested deletion", operatorclient.TargetNamespace, AuthConfigCMName)
+		if errs := o.removeAuthConfig(genericListers, recorder, targetAuthConfig); len(errs) > 0 {
+			return existingConfig, errs
 		}
 
 		return nil, nil
@@ -124,33 +227,145 @@ func (o *externalOIDC) ObserveExternalOIDC(genericListers configobserver.Listers
 
 	// auth type is OIDC
 
+	if missing := missingOIDCClients(auth, o.requiredOIDCClients); len(missing) > 0 {
+		if authConfig, _, _ := unstructured.NestedStringSlice(existingConfig, authConfigPath...); len(authConfig) == 0 {
+			return existingConfig, []error{fmt.Errorf("no OIDC client configured for required components %v", missing)}
+		}
+
+		// OIDC is already configured; failing now would freeze its config, so only warn
+		recorder.Warningf("ObserveExternalOIDC", "No OIDC client configured for required components %v", missing)
+	}
+
+	expectedOIDCClients, err := enabledOIDCComponents(listers, o.expectedOIDCClients)
+	if err != nil {
+		return existingConfig, []error{err}
+	}
+
+	if missing := missingOIDCClients(auth, expectedOIDCClients); len(missing) > 0 {
+		recorder.Warningf("ObserveExternalOIDC", "No OIDC client configured for components %v; they will not be able to log users in via OIDC", missing)
+	}
+
+	// the kube-apiserver refuses JWT issuers that collide with its own service
+	// account issuers, so validate against the ones observed for it
+	serviceAccountIssuers, _, err := unstructured.NestedStringSlice(existingConfig, serviceAccountIssuerPath...)
+	if err != nil {
+		return existingConfig, []error{fmt.Errorf("unable to extract service account issuers from unstructured: %v", err)}
+	}
+
 	sourceAuthConfig, err := validateSourceConfigMap(listers)
 	if err != nil {
 		return existingConfig, []error{err}
 	} else if sourceAuthConfig == nil {
-		klog.Warningf("configmap %s/%s not found; skipping configuration of OIDC", SourceAuthConfigCMNamespace, AuthConfigCMName)
+		// the source configmap might not have been rendered yet; this is expected
+		// right after switching to OIDC, so wait for it without erroring
+		recorder.Eventf("ObserveExternalOIDC", "OIDC auth configmap %s/%s not found; waiting for it before configuring OIDC", SourceAuthConfigCMNamespace, AuthConfigCMName)
+		klog.InfoS("configmap not found; skipping configuration of OIDC", "configMap", klog.KRef(SourceAuthConfigCMNamespace, AuthConfigCMName), "authType", auth.Spec.Type)
 		return existingConfig, nil
 	}
 
+	if err := validateAuthConfig(sourceAuthConfig.Data[authConfigKeyName], serviceAccountIssuers); err != nil {
+		if targetAuthConfig == nil || targetAuthConfig.Data[authConfigKeyName] != sourceAuthConfig.Data[authConfigKeyName] {
+			return existingConfig, []error{fmt.Errorf("configmap %s/%s is invalid: %v", SourceAuthConfigCMNamespace, AuthConfigCMName, err)}
+		}
+
+		// the validation uses the feature gates of this operator rather than the cluster's,
+		// so it can reject a config the apiserver accepts; the config is already synced,
+		// so blocking on it would only freeze the observation
+		recorder.Warningf("ObserveExternalOIDC", "OIDC auth configmap %s/%s failed validation: %v", SourceAuthConfigCMNamespace, AuthConfigCMName, err)
+	}
+
+	observedConfig := make(map[string]interface{})
+	if err := unstructured.SetNestedStringSlice(observedConfig, []string{path.Join("/etc/kubernetes/static-pod-resources/configmaps/", AuthConfigCMName, authConfigKeyName)}, authConfigPath...); err != nil {
+		return existingConfig, []error{err}
+	}
+
 	if err := genericListers.ResourceSyncer().SyncConfigMap(
-		resourcesynccontroller.ResourceLocation{Namespace: operatorclient.TargetNamespace, Name: AuthConfigCMName},
+		resourcesynccontroller.ResourceLocation{Namespace: o.targetNamespace, Name: AuthConfigCMName},
 		resourcesynccontroller.ResourceLocation{Namespace: sourceAuthConfig.Namespace, Name: sourceAuthConfig.Name},
 	); err != nil {
+		if equality.Semantic.DeepEqual(configobserver.Pruned(existingConfig, authConfigPath), observedConfig) {
+			// the apiserver already points to the auth config, so a failed sync only delays
+			// an update of its content; don't fail the whole observation over it
+			recorder.Warningf("ObserveExternalOIDC", "Failed to sync OIDC auth configmap %s/%s: %v", o.targetNamespace, AuthConfigCMName, err)
+			return existingConfig, nil
+		}
 		return existingConfig, []error{err}
 	}
 
-	if targetAuthConfig == nil {
-		recorder.Eventf("ObserveExternalOIDC", "OIDC auth configmap %s/%s does not exist; requested sync", operatorclient.TargetNamespace, AuthConfigCMName)
+	newIssuers := issuerSummary(sourceAuthConfig.Data[authConfigKeyName])
+	klog.V(2).InfoS("Requested sync of OIDC auth config", "configMap", klog.KRef(o.targetNamespace, AuthConfigCMName), "authType", auth.Spec.Type, "issuers", newIssuers)
+
+	if targetAuthConfig == nil || len(targetAuthConfig.Data[authConfigKeyName]) == 0 {
+		recorder.Eventf("ObserveExternalOIDC", "OIDC auth configmap %s/%s is not synced yet; requested sync", o.targetNamespace, AuthConfigCMName)
+
+		// the auth config must be in place before the apiserver is pointed at it, otherwise
+		// a revision could be rolled out without it and the apiserver would fail to start;
+		// the sync of the target configmap triggers another observation
+		return existingConfig, nil
 	}
 
-	observedConfig := make(map[string]interface{})
-	if err := unstructured.SetNestedStringSlice(observedConfig, []string{path.Join("/etc/kubernetes/static-pod-resources/configmaps/", AuthConfigCMName, authConfigKeyName)}, authConfigPath...); err != nil {
-		return existingConfig, []error{err}
+	if targetAuthConfig.Data[authConfigKeyName] == sourceAuthConfig.Data[authConfigKeyName] {
+		// SyncConfigMap only registers the sync; the target is known to be up to date
+		// only once its content matches the source
+		externalOIDCConfigSyncTimestampGauge.Set(float64(o.clock.Now().Unix()))
+		if err := setExternalOIDCIssuerInfo(targetAuthConfig.Data[authConfigKeyName]); err != nil {
+			klog.ErrorS(err, "Failed to read OIDC issuers", "configMap", klog.KRef(o.targetNamespace, AuthConfigCMName))
+		}
+	}
+
+	if oldIssuers := issuerSummary(targetAuthConfig.Data[authConfigKeyName]); oldIssuers != newIssuers {
+		recorder.Eventf("ObserveExternalOIDC", "OIDC issuers changed from %s to %s", oldIssuers, newIssuers)
+
+		// removing an issuer immediately invalidates all tokens it has issued
+		if removed := removedIssuers(targetAuthConfig.Data[authConfigKeyName], sourceAuthConfig.Data[authConfigKeyName]); len(removed) > 0 {
+			recorder.Warningf("ObserveExternalOIDC", "OIDC issuers %v are being removed; tokens issued by them will be rejected once the new configuration rolls out", removed)
+		}
 	}
 
 	return observedConfig, nil
 }
 
+// removeAuthConfig requests the deletion of the auth config synced into the apiserver's
+// namespace; targetAuthConfig is the currently synced configmap, if any.
+func (o *externalOIDC) removeAuthConfig(listers configobserver.Listers, recorder events.Recorder, targetAuthConfig *corev1.ConfigMap) []error {
+	// empty source name/namespace effectively deletes target configmap
+	if err := listers.ResourceSyncer().SyncConfigMap(
+		resourcesynccontroller.ResourceLocation{Namespace: o.targetNamespace, Name: AuthConfigCMName},
+		resourcesynccontroller.ResourceLocation{Namespace: "", Name: ""},
+	); err != nil {
+		return []error{err}
+	}
+
+	if targetAuthConfig != nil {
+		recorder.Eventf("ObserveExternalOIDC", "OIDC auth configmap %s/%s exists; requested deletion", o.targetNamespace, AuthConfigCMName)
+	}
+
+	externalOIDCIssuerInfoGauge.Reset()
+	return nil
+}
+
+// currentFeatureGates returns the current feature gates. If reading them fails
+// but they have been read successfully before, the last known feature gates are
+// returned instead, so that a transient error does not fail the observation.
+func (o *externalOIDC) currentFeatureGates() (featuregates.FeatureGate, error) {
+	featureGates, err := o.featureGateAccessor.CurrentFeatureGates()
+
+	o.lastFeatureGatesLock.Lock()
+	defer o.lastFeatureGatesLock.Unlock()
+
+	if err == nil {
+		o.lastFeatureGates = featureGates
+		return featureGates, nil
+	}
+
+	if o.lastFeatureGates == nil {
+		return nil, err
+	}
+
+	klog.ErrorS(err, "Failed to read current feature gates; using last known feature gates")
+	return o.lastFeatureGates, nil
+}
+
 func validateSourceConfigMap(listers configobservation.Listers) (*corev1.ConfigMap, error) {
 	sourceAuthConfig, err := listers.ConfigMapLister().ConfigMaps(SourceAuthConfigCMNamespace).Get(AuthConfigCMName)
 	if errors.IsNotFound(err) {
@@ -167,3 +382,192 @@ func validateSourceConfigMap(listers configobservation.Listers) (*corev1.ConfigM
 
 	return sourceAuthConfig, nil
 }
+
+// validateAuthConfig runs the given serialized structured auth config through the
+// kube-apiserver's own validation, so that a config the apiserver would refuse to
+// start with never gets rolled out. JWT issuers must not match any of the given
+// disallowed issuers, which are the service account issuers of the kube-apiserver.
+//
+// The upstream validation consults utilfeature.DefaultFeatureGate, e.g. for
+// StructuredAuthenticationConfiguration, StructuredAuthenticationConfigurationEgressSelector
+// and AnonymousAuthConfigurableEndpoints. That is the feature gate of this
+// operator binary, so it reflects the upstream defaults of the vendored
+// k8s.io/apiserver and not the feature gates enabled on the cluster; callers
+// must not block on a rejected config the apiserver may already be running with.
+func validateAuthConfig(data string, disallowedIssuers []string) error {
+	obj, err := runtime.Decode(authConfigCodecs.UniversalDecoder(), []byte(data))
+	if err != nil {
+		return fmt.Errorf("failed to decode '%s': %v", authConfigKeyName, err)
+	}
+
+	authConfig, ok := obj.(*apiserver.AuthenticationConfiguration)
+	if !ok {
+		return fmt.Errorf("unexpected type %T in '%s'", obj, authConfigKeyName)
+	}
+
+	return validation.ValidateAuthenticationConfiguration(authConfigCELCompiler(), authConfig, disallowedIssuers).ToAggregate()
+}
+
+// missingOIDCClients returns the components, formatted as namespace/name, that
+// have no OIDC client configured in any of the OIDC providers of the given
+// authentication resource.
+func missingOIDCClients(auth *configv1.Authentication, components []oidcComponent) []string {
+	configured := sets.New[string]()
+	for _, provider := range auth.Spec.OIDCProviders {
+		for _, client := range provider.OIDCClients {
+			configured.Insert(oidcComponent{name: client.ComponentName, namespace: client.ComponentNamespace}.String())
+		}
+	}
+
+	missing := []string{}
+	for _, component := range components {
+		if !configured.Has(component.String()) {
+			missing = append(missing, component.String())
+		}
+	}
+
+	return missing
+}
+
+// enabledOIDCComponents returns the given components without those provided by
+// a cluster capability that is not enabled.
+func enabledOIDCComponents(listers configobservation.Listers, components []oidcComponent) ([]oidcComponent, error) {
+	var enabledCapabilities sets.Set[configv1.ClusterVersionCapability]
+
+	enabled := []oidcComponent{}
+	for _, component := range components {
+		if len(component.capability) == 0 {
+			enabled = append(enabled, component)
+			continue
+		}
+
+		if enabledCapabilities == nil {
+			clusterVersion, err := listers.ClusterVersionLister.Get("version")
+			if errors.IsNotFound(err) {
+				// without a cluster version, all capabilities are considered enabled
+				return components, nil
+			} else if err != nil {
+				return nil, err
+			}
+			enabledCapabilities = sets.New(clusterVersion.Status.Capabilities.EnabledCapabilities...)
+		}
+
+		if enabledCapabilities.Has(component.capability) {
+			enabled = append(enabled, component)
+		}
+	}
+
+	return enabled, nil
+}
+
+// dedupingRecorder records an event only if it was not recorded with the same
+// message during the previous observation; events that are not recorded again
+// are forgotten, so they get recorded once more when they reoccur.
+type dedupingRecorder struct {
+	events.Recorder
+
+	previous map[string]string
+	current  map[string]string
+}
+
+func (r *dedupingRecorder) Event(reason, message string) {
+	if !r.recordedBefore(corev1.EventTypeNormal, reason, message, message) {
+		r.Recorder.Event(reason, message)
+	}
+}
+
+func (r *dedupingRecorder) Eventf(reason, messageFmt string, args ...interface{}) {
+	if !r.recordedBefore(corev1.EventTypeNormal, reason, messageFmt, fmt.Sprintf(messageFmt, args...)) {
+		r.Recorder.Eventf(reason, messageFmt, args...)
+	}
+}
+
+func (r *dedupingRecorder) Warning(reason, message string) {
+	if !r.recordedBefore(corev1.EventTypeWarning, reason, message, message) {
+		r.Recorder.Warning(reason, message)
+	}
+}
+
+func (r *dedupingRecorder) Warningf(reason, messageFmt string, args ...interface{}) {
+	if !r.recordedBefore(corev1.EventTypeWarning, reason, messageFmt, fmt.Sprintf(messageFmt, args...)) {
+		r.Recorder.Warningf(reason, messageFmt, args...)
+	}
+}
+
+// recordedBefore remembers the given event, identified by its type, reason and
+// message format, and returns whether the previous observation recorded it with
+// the same message.
+func (r *dedupingRecorder) recordedBefore(eventType, reason, messageFmt, message string) bool {
+	key := eventType + "/" + reason + "/" + messageFmt
+	if r.current == nil {
+		r.current = map[string]string{}
+	}
+	r.current[key] = message
+
+	previous, found := r.previous[key]
+	return found && previous == message
+}
+
+// authConfigIssuers is the subset of the structured authentication config
+// that describes the configured issuers.
+type authConfigIssuers struct {
+	JWT []struct {
+		Issuer struct {
+			URL       string   `json:"url"`
+			Audiences []string `json:"audiences"`
+		} `json:"issuer"`
+	} `json:"jwt"`
+}
+
+func parseAuthConfigIssuers(authConfig string) (*authConfigIssuers, error) {
+	issuers := &authConfigIssuers{}
+	if err := json.Unmarshal([]byte(authConfig), issuers); err != nil {
+		return nil, err
+	}
+
+	return issuers, nil
+}
+
+func (i *authConfigIssuers) urls() sets.Set[string] {
+	urls := sets.New[string]()
+	for _, jwt := range i.JWT {
+		urls.Insert(jwt.Issuer.URL)
+	}
+	return urls
+}
+
+// issuerSummary returns a human-readable summary of the issuer URLs and
+// audiences found in the given serialized structured authentication config.
+func issuerSummary(authConfig string) string {
+	issuers, err := parseAuthConfigIssuers(authConfig)
+	if err != nil {
+		return "<unparseable>"
+	}
+
+	summaries := []string{}
+	for _, jwt := range issuers.JWT {
+		summaries = append(summaries, fmt.Sprintf("%s (audiences: %s)", jwt.Issuer.URL, strings.Join(jwt.Issuer.Audiences, ",")))
+	}
+
+	if len(summaries) == 0 {
+		return "[]"
+	}
+
+	return "[" + strings.Join(summaries, "; ") + "]"
+}
+
+// removedIssuers returns the sorted issuer URLs that exist in the old serialized
+// structured authentication config but not in the new one.
+func removedIssuers(oldAuthConfig, newAuthConfig string) []string {
+	oldIssuers, err := parseAuthConfigIssuers(oldAuthConfig)
+	if err != nil {
+		return nil
+	}
+
+	newIssuers, err := parseAuthConfigIssuers(newAuthConfig)
+	if err != nil {
+		return nil
+	}
+
+	return sets.List(oldIssuers.urls().Difference(newIssuers.urls()))
+}
@@ -0,0 +1,55 @@
+package auth
+
+import (
+	"sync"
+
+	"k8s.io/component-base/metrics"
+	"k8s.io/component-base/metrics/legacyregistry"
+)
+
+var (
+	registerExternalOIDCMetrics sync.Once
+
+	externalOIDCEnabledGauge = metrics.NewGauge(&metrics.GaugeOpts{
+		Name: "kube_apiserver_external_oidc_enabled",
+		Help: "Reports whether the kube-apiserver is configured with an external OIDC structured authentication config.",
+	})
+
+	externalOIDCConfigSyncTimestampGauge = metrics.NewGauge(&metrics.GaugeOpts{
+		Name: "kube_apiserver_external_oidc_config_sync_timestamp_seconds",
+		Help: "Reports the last time the structured authentication config in the kube-apiserver namespace was observed to match its source.",
+	})
+
+	externalOIDCIssuerInfoGauge = metrics.NewGaugeVec(&metrics.GaugeOpts{
+		Name: "kube_apiserver_external_oidc_issuer_info",
+		Help: "Reports the OIDC issuers configured in the structured authentication config.",
+	}, []string{"issuer"})
+)
+
+// RegisterExternalOIDCMetrics registers the metrics reported by the external OIDC config observer.
+func RegisterExternalOIDCMetrics() {
+	registerExternalOIDCMetrics.Do(func() {
+		legacyregistry.MustRegister(externalOIDCEnabledGauge)
+		legacyregistry.MustRegister(externalOIDCConfigSyncTimestampGauge)
+		legacyregistry.MustRegister(externalOIDCIssuerInfoGauge)
+	})
+}
+
+// setExternalOIDCIssuerInfo resets the issuer info metric to the issuers
+// found in the given serialized structured authentication config.
+func setExternalOIDCIssuerInfo(authConfig string) error {
+	externalOIDCIssuerInfoGauge.Reset()
+
+	issuers, err := parseAuthConfigIssuers(authConfig)
+	if err != nil {
+		return err
+	}
+
+	for _, jwt := range issuers.JWT {
+		if len(jwt.Issuer.URL) > 0 {
+			externalOIDCIssuerInfoGauge.WithLabelValues(jwt.Issuer.URL).Set(1)
+		}
+	}
+
+	return nil
+}
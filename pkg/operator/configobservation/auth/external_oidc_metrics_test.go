@@ -0,0 +1,55 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/component-base/metrics/testutil"
+)
+
+func TestSetExternalOIDCIssuerInfo(t *testing.T) {
+	RegisterExternalOIDCMetrics()
+
+	for _, tt := range []struct {
+		name        string
+		authConfig  string
+		expected    string
+		expectError bool
+	}{
+		{
+			name:       "no jwt authenticators",
+			authConfig: `{"kind":"AuthenticationConfiguration","apiVersion":"apiserver.config.k8s.io/v1beta1"}`,
+			expected:   "",
+		},
+		{
+			name:       "multiple jwt authenticators",
+			authConfig: `{"kind":"AuthenticationConfiguration","apiVersion":"apiserver.config.k8s.io/v1beta1","jwt":[{"issuer":{"url":"https://a.example.com"}},{"issuer":{"url":"https://b.example.com"}}]}`,
+			expected: `
+# HELP kube_apiserver_external_oidc_issuer_info [ALPHA] Reports the OIDC issuers configured in the structured authentication config.
+# TYPE kube_apiserver_external_oidc_issuer_info gauge
+kube_apiserver_external_oidc_issuer_info{issuer="https://a.example.com"} 1
+kube_apiserver_external_oidc_issuer_info{issuer="https://b.example.com"} 1
+`,
+		},
+		{
+			name:        "invalid auth config",
+			authConfig:  `{`,
+			expected:    "",
+			expectError: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			// ensure stale issuers from a previous case get reset
+			externalOIDCIssuerInfoGauge.WithLabelValues("https://stale.example.com").Set(1)
+
+			err := setExternalOIDCIssuerInfo(tt.authConfig)
+			if tt.expectError != (err != nil) {
+				t.Errorf("expected error: %v; got: %v", tt.expectError, err)
+			}
+
+			if err := testutil.CollectAndCompare(externalOIDCIssuerInfoGauge, strings.NewReader(tt.expected), "kube_apiserver_external_oidc_issuer_info"); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -3,12 +3,16 @@ package auth
 import (
 	"fmt"
 	"path"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	configv1 "github.com/openshift/api/config/v1"
 	"github.com/openshift/api/features"
 	configlistersv1 "github.com/openshift/client-go/config/listers/config/v1"
 	"github.com/openshift/cluster-kube-apiserver-operator/pkg/operator/configobservation"
+	"github.com/openshift/cluster-kube-apiserver-operator/pkg/operator/operatorclient"
 	"github.com/openshift/library-go/pkg/operator/configobserver/featuregates"
 	"github.com/openshift/library-go/pkg/operator/events"
 
@@ -20,7 +24,9 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	corelistersv1 "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/component-base/metrics/testutil"
 	"k8s.io/utils/clock"
+	clocktesting "k8s.io/utils/clock/testing"
 )
 
 var (
@@ -31,6 +37,13 @@ var (
 		nil,
 	)
 
+	featureGatesWithoutOIDC = featuregates.NewHardcodedFeatureGateAccessForTesting(
+		[]configv1.FeatureGateName{},
+		[]configv1.FeatureGateName{features.FeatureGateExternalOIDC},
+		makeClosedChannel(),
+		nil,
+	)
+
 	featureGatesWithExternalClaimsSourcing = featuregates.NewHardcodedFeatureGateAccessForTesting(
 		[]configv1.FeatureGateName{features.FeatureGateExternalOIDC, features.FeatureGateExternalOIDCExternalClaimsSourcing},
 		[]configv1.FeatureGateName{},
@@ -56,12 +69,43 @@ var (
 		},
 	}
 
+	authResourceWithOIDCClients = configv1.Authentication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "cluster",
+		},
+		Spec: configv1.AuthenticationSpec{
+			Type: configv1.AuthenticationTypeOIDC,
+			OIDCProviders: []configv1.OIDCProvider{
+				{
+					Name: "test-oidc-provider",
+					OIDCClients: []configv1.OIDCClientConfig{
+						{ComponentName: "console", ComponentNamespace: "openshift-console", ClientID: "console-oidc-client"},
+						{ComponentName: "kube-apiserver", ComponentNamespace: "openshift-kube-apiserver", ClientID: "test-oidc-client"},
+					},
+				},
+			},
+		},
+	}
+
 	baseConfig = map[string]interface{}{
 		"apiServerArguments": map[string]interface{}{
 			"authentication-config": []interface{}{path.Join("/etc/kubernetes/static-pod-resources/configmaps/", AuthConfigCMName, authConfigKeyName)},
 		},
 	}
 
+	otherPathConfig = map[string]interface{}{
+		"apiServerArguments": map[string]interface{}{
+			"authentication-config": []interface{}{"/etc/kubernetes/other/auth-config.json"},
+		},
+	}
+
+	serviceAccountIssuerConfig = map[string]interface{}{
+		"apiServerArguments": map[string]interface{}{
+			"authentication-config":  []interface{}{path.Join("/etc/kubernetes/static-pod-resources/configmaps/", AuthConfigCMName, authConfigKeyName)},
+			"service-account-issuer": []interface{}{"https://new.example.com"},
+		},
+	}
+
 	baseSourceConfigMap = corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "auth-config",
@@ -82,6 +126,26 @@ var (
 		},
 	}
 
+	rejectedSourceConfigMap = corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "auth-config",
+			Namespace: "openshift-config-managed",
+		},
+		Data: map[string]string{
+			"auth-config.json": `{"kind":"AuthenticationConfiguration","apiVersion":"apiserver.config.k8s.io/v1beta1","jwt":[{"issuer":{"url":"http://new.example.com","audiences":["kube-apiserver"]}}]}`,
+		},
+	}
+
+	rejectedTargetConfigMap = corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "auth-config",
+			Namespace: "openshift-kube-apiserver",
+		},
+		Data: map[string]string{
+			"auth-config.json": `{"kind":"AuthenticationConfiguration","apiVersion":"apiserver.config.k8s.io/v1beta1","jwt":[{"issuer":{"url":"http://new.example.com","audiences":["kube-apiserver"]}}]}`,
+		},
+	}
+
 	emptyValueSourceConfigMap = corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "auth-config",
@@ -102,6 +166,36 @@ var (
 		},
 	}
 
+	issuerSourceConfigMap = corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "auth-config",
+			Namespace: "openshift-config-managed",
+		},
+		Data: map[string]string{
+			"auth-config.json": `{"kind":"AuthenticationConfiguration","apiVersion":"apiserver.config.k8s.io/v1beta1","jwt":[{"issuer":{"url":"https://new.example.com","audiences":["kube-apiserver"]},"claimMappings":{"username":{"claim":"sub","prefix":""}}}]}`,
+		},
+	}
+
+	issuerTargetConfigMap = corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "auth-config",
+			Namespace: "openshift-kube-apiserver",
+		},
+		Data: map[string]string{
+			"auth-config.json": `{"kind":"AuthenticationConfiguration","apiVersion":"apiserver.config.k8s.io/v1beta1","jwt":[{"issuer":{"url":"https://old.example.com","audiences":["kube-apiserver"]}}]}`,
+		},
+	}
+
+	emptyValueTargetConfigMap = corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "auth-config",
+			Namespace: "openshift-kube-apiserver",
+		},
+		Data: map[string]string{
+			"auth-config.json": ``,
+		},
+	}
+
 	baseTargetConfigMap = corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "auth-config",
@@ -117,13 +211,16 @@ func TestObserveExternalOIDC(t *testing.T) {
 	for _, tt := range []struct {
 		name string
 
-		featureGates   featuregates.FeatureGateAccess
-		existingConfig map[string]interface{}
+		featureGates        featuregates.FeatureGateAccess
+		existingConfig      map[string]interface{}
+		requiredOIDCClients []oidcComponent
+		expectedOIDCClients []oidcComponent
 
 		existingSourceConfigMap *corev1.ConfigMap
 		existingTargetConfigMap *corev1.ConfigMap
 
 		auth             *configv1.Authentication
+		clusterVersion   *configv1.ClusterVersion
 		authIndexer      cache.Indexer
 		cmIndexer        cache.Indexer
 		listerErrorForNS sets.Set[string]
@@ -159,25 +256,61 @@ func TestObserveExternalOIDC(t *testing.T) {
 			expectErrors:   true,
 		},
 		{
-			name: "ExternalOIDC feature gate disabled",
-			featureGates: featuregates.NewHardcodedFeatureGateAccessForTesting(
-				[]configv1.FeatureGateName{},
-				[]configv1.FeatureGateName{features.FeatureGateExternalOIDC},
-				makeClosedChannel(),
-				nil,
-			),
-			existingConfig: baseConfig,
-			expectedConfig: baseConfig,
+			name:           "ExternalOIDC feature gate disabled",
+			featureGates:   featureGatesWithoutOIDC,
+			existingConfig: nil,
+			expectedConfig: nil,
+			expectedSynced: nil,
+			expectEvents:   false,
 			expectErrors:   false,
 		},
 		{
-			name: "ExternalOIDC feature gate disabled with oauth config",
-			featureGates: featuregates.NewHardcodedFeatureGateAccessForTesting(
-				[]configv1.FeatureGateName{},
-				[]configv1.FeatureGateName{features.FeatureGateExternalOIDC},
-				makeClosedChannel(),
-				nil,
-			),
+			name:           "ExternalOIDC feature gate disabled after OIDC was configured",
+			featureGates:   featureGatesWithoutOIDC,
+			existingConfig: baseConfig,
+			expectedConfig: nil,
+			expectedSynced: map[string]string{
+				"configmap/auth-config.openshift-kube-apiserver": "DELETE",
+			},
+			expectEvents: true,
+			expectErrors: false,
+		},
+		{
+			name:                    "ExternalOIDC feature gate disabled after OIDC was configured with existing target configmap",
+			featureGates:            featureGatesWithoutOIDC,
+			existingConfig:          baseConfig,
+			existingTargetConfigMap: &baseTargetConfigMap,
+			expectedConfig:          nil,
+			expectedSynced: map[string]string{
+				"configmap/auth-config.openshift-kube-apiserver": "DELETE",
+			},
+			expectEvents: true,
+			expectErrors: false,
+		},
+		{
+			name:             "ExternalOIDC feature gate disabled after OIDC was configured with target configmap lister error",
+			featureGates:     featureGatesWithoutOIDC,
+			existingConfig:   baseConfig,
+			listerErrorForNS: sets.New("openshift-kube-apiserver"),
+			expectedConfig:   baseConfig,
+			expectedSynced:   nil,
+			expectEvents:     false,
+			expectErrors:     true,
+		},
+		{
+			name:                    "ExternalOIDC feature gate disabled after OIDC was configured with syncer error",
+			featureGates:            featureGatesWithoutOIDC,
+			existingConfig:          baseConfig,
+			existingTargetConfigMap: &baseTargetConfigMap,
+			syncerError:             fmt.Errorf("syncer error"),
+			expectedConfig:          baseConfig,
+			expectedSynced:          nil,
+			expectEvents:            false,
+			expectErrors:            true,
+		},
+		{
+			name:         "ExternalOIDC feature gate disabled with oauth config",
+			featureGates: featureGatesWithoutOIDC,
 			existingConfig: map[string]interface{}{
 				"apiServerArguments": map[string]interface{}{
 					"authentication-token-webhook-config-file": []interface{}{webhookTokenAuthenticatorFile},
@@ -240,6 +373,29 @@ func TestObserveExternalOIDC(t *testing.T) {
 			expectErrors: false,
 			expectEvents: true,
 		},
+		{
+			name:                    "OIDC source configmap not found",
+			featureGates:            featureGatesWithOIDC,
+			existingConfig:          nil,
+			existingSourceConfigMap: nil,
+			auth:                    &authResourceWithOIDC,
+			expectedConfig:          nil,
+			expectedSynced:          nil,
+			expectEvents:            true,
+			expectErrors:            false,
+		},
+		{
+			name:                    "OIDC existing config with source configmap not found",
+			featureGates:            featureGatesWithOIDC,
+			existingConfig:          baseConfig,
+			existingSourceConfigMap: nil,
+			existingTargetConfigMap: &baseTargetConfigMap,
+			auth:                    &authResourceWithOIDC,
+			expectedConfig:          baseConfig,
+			expectedSynced:          nil,
+			expectEvents:            true,
+			expectErrors:            false,
+		},
 		{
 			name:                    "OIDC new invalid config with expected key missing",
 			featureGates:            featureGatesWithOIDC,
@@ -259,6 +415,40 @@ func TestObserveExternalOIDC(t *testing.T) {
 			expectEvents:            false,
 			expectErrors:            true,
 		},
+		{
+			name:                    "OIDC updated config rejected by apiserver validation",
+			featureGates:            featureGatesWithOIDC,
+			existingConfig:          baseConfig,
+			existingSourceConfigMap: &rejectedSourceConfigMap,
+			auth:                    &authResourceWithOIDC,
+			expectedConfig:          baseConfig,
+			expectEvents:            false,
+			expectErrors:            true,
+		},
+		{
+			name:                    "OIDC synced config rejected by apiserver validation",
+			featureGates:            featureGatesWithOIDC,
+			existingConfig:          baseConfig,
+			existingSourceConfigMap: &rejectedSourceConfigMap,
+			existingTargetConfigMap: &rejectedTargetConfigMap,
+			auth:                    &authResourceWithOIDC,
+			expectedConfig:          baseConfig,
+			expectedSynced: map[string]string{
+				"configmap/auth-config.openshift-kube-apiserver": "configmap/auth-config.openshift-config-managed",
+			},
+			expectEvents: true,
+			expectErrors: false,
+		},
+		{
+			name:                    "OIDC updated config issuer matches service account issuer",
+			featureGates:            featureGatesWithOIDC,
+			existingConfig:          serviceAccountIssuerConfig,
+			existingSourceConfigMap: &issuerSourceConfigMap,
+			auth:                    &authResourceWithOIDC,
+			expectedConfig:          baseConfig,
+			expectEvents:            false,
+			expectErrors:            true,
+		},
 		{
 			name:                    "OIDC new valid config syncer error",
 			featureGates:            featureGatesWithOIDC,
@@ -272,19 +462,73 @@ func TestObserveExternalOIDC(t *testing.T) {
 			expectErrors:            true,
 		},
 		{
-			name:                    "OIDC new valid config",
+			name:                    "OIDC updated valid config syncer error with unchanged config",
+			featureGates:            featureGatesWithOIDC,
+			syncerError:             fmt.Errorf("syncer error"),
+			existingConfig:          baseConfig,
+			existingSourceConfigMap: &baseSourceConfigMap,
+			existingTargetConfigMap: &baseTargetConfigMap,
+			auth:                    &authResourceWithOIDC,
+			expectedConfig:          baseConfig,
+			expectedSynced:          nil,
+			expectEvents:            true,
+			expectErrors:            false,
+		},
+		{
+			name:                    "OIDC updated valid config syncer error with changed config",
+			featureGates:            featureGatesWithOIDC,
+			syncerError:             fmt.Errorf("syncer error"),
+			existingConfig:          otherPathConfig,
+			existingSourceConfigMap: &baseSourceConfigMap,
+			existingTargetConfigMap: &baseTargetConfigMap,
+			auth:                    &authResourceWithOIDC,
+			expectedConfig:          otherPathConfig,
+			expectedSynced:          nil,
+			expectEvents:            false,
+			expectErrors:            true,
+		},
+		{
+			name:                    "OIDC new valid config with target configmap not synced yet",
 			featureGates:            featureGatesWithOIDC,
 			existingConfig:          nil,
 			existingSourceConfigMap: &baseSourceConfigMap,
 			existingTargetConfigMap: nil,
 			auth:                    &authResourceWithOIDC,
-			expectedConfig:          baseConfig,
+			expectedConfig:          nil,
+			expectedSynced: map[string]string{
+				"configmap/auth-config.openshift-kube-apiserver": "configmap/auth-config.openshift-config-managed",
+			},
+			expectEvents: true,
+			expectErrors: false,
+		},
+		{
+			name:                    "OIDC new valid config with empty target configmap",
+			featureGates:            featureGatesWithOIDC,
+			existingConfig:          nil,
+			existingSourceConfigMap: &baseSourceConfigMap,
+			existingTargetConfigMap: &emptyValueTargetConfigMap,
+			auth:                    &authResourceWithOIDC,
+			expectedConfig:          nil,
 			expectedSynced: map[string]string{
 				"configmap/auth-config.openshift-kube-apiserver": "configmap/auth-config.openshift-config-managed",
 			},
 			expectEvents: true,
 			expectErrors: false,
 		},
+		{
+			name:                    "OIDC new valid config with target configmap synced",
+			featureGates:            featureGatesWithOIDC,
+			existingConfig:          nil,
+			existingSourceConfigMap: &baseSourceConfigMap,
+			existingTargetConfigMap: &baseTargetConfigMap,
+			auth:                    &authResourceWithOIDC,
+			expectedConfig:          baseConfig,
+			expectedSynced: map[string]string{
+				"configmap/auth-config.openshift-kube-apiserver": "configmap/auth-config.openshift-config-managed",
+			},
+			expectEvents: false,
+			expectErrors: false,
+		},
 		{
 			name:                    "OIDC updated valid config without changes",
 			featureGates:            featureGatesWithOIDC,
@@ -314,49 +558,169 @@ func TestObserveExternalOIDC(t *testing.T) {
 			expectErrors: false,
 		},
 		{
-			name:                    "ExternalOIDCExternalClaimsSourcing enabled with no target configmap",
-			featureGates:            featureGatesWithExternalClaimsSourcing,
+			name:                    "OIDC updated valid config with issuer changes",
+			featureGates:            featureGatesWithOIDC,
 			existingConfig:          baseConfig,
-			existingTargetConfigMap: nil,
-			expectedConfig:          nil,
+			existingSourceConfigMap: &issuerSourceConfigMap,
+			existingTargetConfigMap: &issuerTargetConfigMap,
+			auth:                    &authResourceWithOIDC,
+			expectedConfig:          baseConfig,
 			expectedSynced: map[string]string{
-				"configmap/auth-config.openshift-kube-apiserver": "DELETE",
+				"configmap/auth-config.openshift-kube-apiserver": "configmap/auth-config.openshift-config-managed",
 			},
-			expectEvents: false,
+			expectEvents: true,
 			expectErrors: false,
 		},
 		{
-			name:                    "ExternalOIDCExternalClaimsSourcing enabled with existing target configmap",
-			featureGates:            featureGatesWithExternalClaimsSourcing,
+			name:                    "OIDC valid config with missing expected OIDC client",
+			featureGates:            featureGatesWithOIDC,
 			existingConfig:          baseConfig,
+			expectedOIDCClients:     defaultExpectedOIDCClients,
+			existingSourceConfigMap: &baseSourceConfigMap,
 			existingTargetConfigMap: &baseTargetConfigMap,
-			expectedConfig:          nil,
+			auth:                    &authResourceWithOIDC,
+			expectedConfig:          baseConfig,
 			expectedSynced: map[string]string{
-				"configmap/auth-config.openshift-kube-apiserver": "DELETE",
+				"configmap/auth-config.openshift-kube-apiserver": "configmap/auth-config.openshift-config-managed",
 			},
 			expectEvents: true,
 			expectErrors: false,
 		},
 		{
-			name:             "ExternalOIDCExternalClaimsSourcing enabled with target configmap lister error",
-			featureGates:     featureGatesWithExternalClaimsSourcing,
-			existingConfig:   baseConfig,
-			listerErrorForNS: sets.New("openshift-kube-apiserver"),
-			expectedConfig:   baseConfig,
-			expectedSynced:   nil,
-			expectEvents:     false,
-			expectErrors:     true,
+			name:                    "OIDC valid config with missing expected OIDC client for enabled capability",
+			featureGates:            featureGatesWithOIDC,
+			existingConfig:          baseConfig,
+			expectedOIDCClients:     defaultExpectedOIDCClients,
+			existingSourceConfigMap: &baseSourceConfigMap,
+			existingTargetConfigMap: &baseTargetConfigMap,
+			auth:                    &authResourceWithOIDC,
+			clusterVersion:          clusterVersionWithCapabilities(configv1.ClusterVersionCapabilityConsole),
+			expectedConfig:          baseConfig,
+			expectedSynced: map[string]string{
+				"configmap/auth-config.openshift-kube-apiserver": "configmap/auth-config.openshift-config-managed",
+			},
+			expectEvents: true,
+			expectErrors: false,
 		},
 		{
-			name:                    "ExternalOIDCExternalClaimsSourcing enabled with syncer error",
-			featureGates:            featureGatesWithExternalClaimsSourcing,
+			name:                    "OIDC valid config with missing expected OIDC client for disabled capability",
+			featureGates:            featureGatesWithOIDC,
 			existingConfig:          baseConfig,
+			expectedOIDCClients:     defaultExpectedOIDCClients,
+			existingSourceConfigMap: &baseSourceConfigMap,
 			existingTargetConfigMap: &baseTargetConfigMap,
-			syncerError:             fmt.Errorf("syncer error"),
+			auth:                    &authResourceWithOIDC,
+			clusterVersion:          clusterVersionWithCapabilities(),
 			expectedConfig:          baseConfig,
-			expectedSynced:          nil,
-			expectEvents:            false,
-			expectErrors:            true,
+			expectedSynced: map[string]string{
+				"configmap/auth-config.openshift-kube-apiserver": "configmap/auth-config.openshift-config-managed",
+			},
+			expectEvents: false,
+			expectErrors: false,
+		},
+		{
+			name:                    "OIDC valid config with expected OIDC client",
+			featureGates:            featureGatesWithOIDC,
+			existingConfig:          baseConfig,
+			expectedOIDCClients:     defaultExpectedOIDCClients,
+			existingSourceConfigMap: &baseSourceConfigMap,
+			existingTargetConfigMap: &baseTargetConfigMap,
+			auth:                    &authResourceWithOIDCClients,
+			expectedConfig:          baseConfig,
+			expectedSynced: map[string]string{
+				"configmap/auth-config.openshift-kube-apiserver": "configmap/auth-config.openshift-config-managed",
+			},
+			expectEvents: false,
+			expectErrors: false,
+		},
+		{
+			name:                    "OIDC valid config with missing required OIDC client",
+			featureGates:            featureGatesWithOIDC,
+			existingConfig:          nil,
+			requiredOIDCClients:     []oidcComponent{{name: "kube-apiserver", namespace: "openshift-kube-apiserver"}},
+			existingSourceConfigMap: &baseSourceConfigMap,
+			existingTargetConfigMap: &baseTargetConfigMap,
+			auth:                    &authResourceWithOIDC,
+			expectedConfig:          nil,
+			expectedSynced:          nil,
+			expectEvents:            false,
+			expectErrors:            true,
+		},
+		{
+			name:                    "OIDC already configured with missing required OIDC client",
+			featureGates:            featureGatesWithOIDC,
+			existingConfig:          baseConfig,
+			requiredOIDCClients:     []oidcComponent{{name: "kube-apiserver", namespace: "openshift-kube-apiserver"}},
+			existingSourceConfigMap: &baseSourceConfigMap,
+			existingTargetConfigMap: &baseTargetConfigMap,
+			auth:                    &authResourceWithOIDC,
+			expectedConfig:          baseConfig,
+			expectedSynced: map[string]string{
+				"configmap/auth-config.openshift-kube-apiserver": "configmap/auth-config.openshift-config-managed",
+			},
+			expectEvents: true,
+			expectErrors: false,
+		},
+		{
+			name:                    "OIDC valid config with required OIDC client and missing expected OIDC client",
+			featureGates:            featureGatesWithOIDC,
+			existingConfig:          baseConfig,
+			requiredOIDCClients:     []oidcComponent{{name: "kube-apiserver", namespace: "openshift-kube-apiserver"}},
+			expectedOIDCClients:     []oidcComponent{{name: "oauth-openshift", namespace: "openshift-authentication"}},
+			existingSourceConfigMap: &baseSourceConfigMap,
+			existingTargetConfigMap: &baseTargetConfigMap,
+			auth:                    &authResourceWithOIDCClients,
+			expectedConfig:          baseConfig,
+			expectedSynced: map[string]string{
+				"configmap/auth-config.openshift-kube-apiserver": "configmap/auth-config.openshift-config-managed",
+			},
+			expectEvents: true,
+			expectErrors: false,
+		},
+		{
+			name:                    "ExternalOIDCExternalClaimsSourcing enabled with no target configmap",
+			featureGates:            featureGatesWithExternalClaimsSourcing,
+			existingConfig:          baseConfig,
+			existingTargetConfigMap: nil,
+			expectedConfig:          nil,
+			expectedSynced: map[string]string{
+				"configmap/auth-config.openshift-kube-apiserver": "DELETE",
+			},
+			expectEvents: false,
+			expectErrors: false,
+		},
+		{
+			name:                    "ExternalOIDCExternalClaimsSourcing enabled with existing target configmap",
+			featureGates:            featureGatesWithExternalClaimsSourcing,
+			existingConfig:          baseConfig,
+			existingTargetConfigMap: &baseTargetConfigMap,
+			expectedConfig:          nil,
+			expectedSynced: map[string]string{
+				"configmap/auth-config.openshift-kube-apiserver": "DELETE",
+			},
+			expectEvents: true,
+			expectErrors: false,
+		},
+		{
+			name:             "ExternalOIDCExternalClaimsSourcing enabled with target configmap lister error",
+			featureGates:     featureGatesWithExternalClaimsSourcing,
+			existingConfig:   baseConfig,
+			listerErrorForNS: sets.New("openshift-kube-apiserver"),
+			expectedConfig:   baseConfig,
+			expectedSynced:   nil,
+			expectEvents:     false,
+			expectErrors:     true,
+		},
+		{
+			name:                    "ExternalOIDCExternalClaimsSourcing enabled with syncer error",
+			featureGates:            featureGatesWithExternalClaimsSourcing,
+			existingConfig:          baseConfig,
+			existingTargetConfigMap: &baseTargetConfigMap,
+			syncerError:             fmt.Errorf("syncer error"),
+			expectedConfig:          baseConfig,
+			expectedSynced:          nil,
+			expectEvents:            false,
+			expectErrors:            true,
 		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
@@ -385,13 +749,25 @@ func TestObserveExternalOIDC(t *testing.T) {
 				tt.cmIndexer.Add(tt.existingTargetConfigMap)
 			}
 
+			cvIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			if tt.clusterVersion != nil {
+				cvIndexer.Add(tt.clusterVersion)
+			}
+
 			listers := configobservation.Listers{
-				AuthConfigLister: configlistersv1.NewAuthenticationLister(tt.authIndexer),
-				ConfigmapLister_: newFakeConfigMapLister(tt.listerErrorForNS, tt.cmIndexer),
-				ResourceSync:     &mockResourceSyncer{t: t, synced: synced, error: tt.syncerError},
+				AuthConfigLister:     configlistersv1.NewAuthenticationLister(tt.authIndexer),
+				ClusterVersionLister: configlistersv1.NewClusterVersionLister(cvIndexer),
+				ConfigmapLister_:     newFakeConfigMapLister(tt.listerErrorForNS, tt.cmIndexer),
+				ResourceSync:         &mockResourceSyncer{t: t, synced: synced, error: tt.syncerError},
 			}
 
-			c := externalOIDC{featureGateAccessor: tt.featureGates}
+			c := externalOIDC{
+				featureGateAccessor: tt.featureGates,
+				clock:               clock.RealClock{},
+				targetNamespace:     operatorclient.TargetNamespace,
+				requiredOIDCClients: tt.requiredOIDCClients,
+				expectedOIDCClients: tt.expectedOIDCClients,
+			}
 			actualConfig, errs := c.ObserveExternalOIDC(listers, eventRecorder, tt.existingConfig)
 
 			if tt.expectErrors != (len(errs) > 0) {
@@ -413,6 +789,246 @@ func TestObserveExternalOIDC(t *testing.T) {
 	}
 }
 
+// flakyFeatureGateAccess fails to return the current feature gates whenever err is set.
+type flakyFeatureGateAccess struct {
+	featuregates.FeatureGateAccess
+	err error
+}
+
+func (f *flakyFeatureGateAccess) CurrentFeatureGates() (featuregates.FeatureGate, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return f.FeatureGateAccess.CurrentFeatureGates()
+}
+
+func TestObserveExternalOIDCIntermittentFeatureGateError(t *testing.T) {
+	cmIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	cmIndexer.Add(&baseSourceConfigMap)
+	cmIndexer.Add(&baseTargetConfigMap)
+	authIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	authIndexer.Add(&authResourceWithOIDC)
+
+	listers := configobservation.Listers{
+		AuthConfigLister: configlistersv1.NewAuthenticationLister(authIndexer),
+		ConfigmapLister_: corelistersv1.NewConfigMapLister(cmIndexer),
+		ResourceSync:     &mockResourceSyncer{t: t, synced: map[string]string{}},
+	}
+
+	featureGates := &flakyFeatureGateAccess{FeatureGateAccess: featureGatesWithOIDC}
+	c := externalOIDC{featureGateAccessor: featureGates, clock: clock.RealClock{}, targetNamespace: operatorclient.TargetNamespace}
+	eventRecorder := events.NewInMemoryRecorder("externaloidctest", clock.RealClock{})
+
+	for _, step := range []struct {
+		name         string
+		err          error
+		expectErrors bool
+	}{
+		{name: "error before feature gates were ever read", err: fmt.Errorf("error"), expectErrors: true},
+		{name: "feature gates read successfully", err: nil, expectErrors: false},
+		{name: "error after feature gates were read", err: fmt.Errorf("error"), expectErrors: false},
+	} {
+		featureGates.err = step.err
+		actualConfig, errs := c.ObserveExternalOIDC(listers, eventRecorder, baseConfig)
+
+		if step.expectErrors != (len(errs) > 0) {
+			t.Errorf("%s: expected errors: %v; got %v", step.name, step.expectErrors, errs)
+		}
+
+		if !equality.Semantic.DeepEqual(baseConfig, actualConfig) {
+			t.Errorf("%s: unexpected config diff: %s", step.name, diff.Diff(baseConfig, actualConfig))
+		}
+	}
+}
+
+func TestObserveExternalOIDCSyncMetrics(t *testing.T) {
+	RegisterExternalOIDCMetrics()
+
+	syncTime := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, tt := range []struct {
+		name                    string
+		existingTargetConfigMap *corev1.ConfigMap
+		expectedTimestamp       float64
+		expectedIssuers         string
+	}{
+		{
+			name:                    "target configmap not synced yet",
+			existingTargetConfigMap: nil,
+			expectedTimestamp:       0,
+			expectedIssuers:         "",
+		},
+		{
+			name:                    "target configmap not up to date",
+			existingTargetConfigMap: &issuerTargetConfigMap,
+			expectedTimestamp:       0,
+			expectedIssuers:         "",
+		},
+		{
+			name:                    "target configmap up to date",
+			existingTargetConfigMap: issuerSourceConfigMap.DeepCopy(),
+			expectedTimestamp:       float64(syncTime.Unix()),
+			expectedIssuers: `
+# HELP kube_apiserver_external_oidc_issuer_info [ALPHA] Reports the OIDC issuers configured in the structured authentication config.
+# TYPE kube_apiserver_external_oidc_issuer_info gauge
+kube_apiserver_external_oidc_issuer_info{issuer="https://new.example.com"} 1
+`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			externalOIDCConfigSyncTimestampGauge.Set(0)
+			externalOIDCIssuerInfoGauge.Reset()
+
+			cmIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			cmIndexer.Add(&issuerSourceConfigMap)
+			if tt.existingTargetConfigMap != nil {
+				targetConfigMap := tt.existingTargetConfigMap.DeepCopy()
+				targetConfigMap.Namespace = operatorclient.TargetNamespace
+				cmIndexer.Add(targetConfigMap)
+			}
+			authIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			authIndexer.Add(&authResourceWithOIDC)
+
+			listers := configobservation.Listers{
+				AuthConfigLister: configlistersv1.NewAuthenticationLister(authIndexer),
+				ConfigmapLister_: corelistersv1.NewConfigMapLister(cmIndexer),
+				ResourceSync:     &mockResourceSyncer{t: t, synced: map[string]string{}},
+			}
+
+			fakeClock := clocktesting.NewFakePassiveClock(syncTime)
+			c := externalOIDC{featureGateAccessor: featureGatesWithOIDC, clock: fakeClock, targetNamespace: operatorclient.TargetNamespace}
+			if _, errs := c.ObserveExternalOIDC(listers, events.NewInMemoryRecorder("externaloidctest", fakeClock), baseConfig); len(errs) > 0 {
+				t.Fatalf("unexpected errors: %v", errs)
+			}
+
+			actual, err := testutil.GetGaugeMetricValue(externalOIDCConfigSyncTimestampGauge)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if actual != tt.expectedTimestamp {
+				t.Errorf("expected sync timestamp %v; got %v", tt.expectedTimestamp, actual)
+			}
+
+			if err := testutil.CollectAndCompare(externalOIDCIssuerInfoGauge, strings.NewReader(tt.expectedIssuers), "kube_apiserver_external_oidc_issuer_info"); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestObserveExternalOIDCTargetNamespace(t *testing.T) {
+	targetConfigMap := baseTargetConfigMap.DeepCopy()
+	targetConfigMap.Namespace = "test-namespace"
+
+	cmIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	cmIndexer.Add(&baseSourceConfigMap)
+	cmIndexer.Add(targetConfigMap)
+	authIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	authIndexer.Add(&authResourceWithOIDC)
+
+	cvIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+
+	synced := map[string]string{}
+	listers := configobservation.Listers{
+		AuthConfigLister:     configlistersv1.NewAuthenticationLister(authIndexer),
+		ClusterVersionLister: configlistersv1.NewClusterVersionLister(cvIndexer),
+		ConfigmapLister_:     corelistersv1.NewConfigMapLister(cmIndexer),
+		ResourceSync:         &mockResourceSyncer{t: t, synced: synced},
+	}
+
+	observe := NewObserveExternalOIDC(featureGatesWithOIDC, "test-namespace", clock.RealClock{})
+	actualConfig, errs := observe(listers, events.NewInMemoryRecorder("externaloidctest", clock.RealClock{}), nil)
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+
+	// the config is only set once the target configmap in the given namespace is synced
+	if !equality.Semantic.DeepEqual(baseConfig, actualConfig) {
+		t.Errorf("unexpected config diff: %s", diff.Diff(baseConfig, actualConfig))
+	}
+
+	expectedSynced := map[string]string{
+		"configmap/auth-config.test-namespace": "configmap/auth-config.openshift-config-managed",
+	}
+	if !equality.Semantic.DeepEqual(expectedSynced, synced) {
+		t.Errorf("expected resources not synced: %s", diff.Diff(expectedSynced, synced))
+	}
+}
+
+func BenchmarkObserveExternalOIDCFeatureGateDisabled(b *testing.B) {
+	featureGates := featuregates.NewHardcodedFeatureGateAccessForTesting(
+		[]configv1.FeatureGateName{},
+		[]configv1.FeatureGateName{features.FeatureGateExternalOIDC},
+		makeClosedChannel(),
+		nil,
+	)
+	existingConfig := map[string]interface{}{
+		"apiServerArguments": map[string]interface{}{
+			"authentication-token-webhook-config-file": []interface{}{webhookTokenAuthenticatorFile},
+		},
+	}
+
+	// listers are left empty on purpose; the disabled path must not use them
+	listers := configobservation.Listers{}
+	eventRecorder := events.NewInMemoryRecorder("externaloidctest", clock.RealClock{})
+	c := externalOIDC{featureGateAccessor: featureGates}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		c.ObserveExternalOIDC(listers, eventRecorder, existingConfig)
+	}
+}
+
+func TestObserveExternalOIDCRequiredOIDCClients(t *testing.T) {
+	for _, tt := range []struct {
+		name           string
+		options        []externalOIDCOptionFunc
+		expectedConfig map[string]interface{}
+		expectErrors   bool
+	}{
+		{
+			// no component is required by default, as the Authentication API only
+			// accepts OIDC clients of components the authentication operator publishes
+			name:           "no required OIDC clients by default",
+			expectedConfig: baseConfig,
+		},
+		{
+			name:           "missing opted-in required OIDC client",
+			options:        []externalOIDCOptionFunc{WithRequiredOIDCClient("openshift-kube-apiserver", "kube-apiserver")},
+			expectedConfig: nil,
+			expectErrors:   true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			cmIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			cmIndexer.Add(&baseSourceConfigMap)
+			cmIndexer.Add(&baseTargetConfigMap)
+			authIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			authIndexer.Add(&authResourceWithOIDC)
+			cvIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+
+			listers := configobservation.Listers{
+				AuthConfigLister:     configlistersv1.NewAuthenticationLister(authIndexer),
+				ClusterVersionLister: configlistersv1.NewClusterVersionLister(cvIndexer),
+				ConfigmapLister_:     corelistersv1.NewConfigMapLister(cmIndexer),
+				ResourceSync:         &mockResourceSyncer{t: t, synced: map[string]string{}},
+			}
+
+			observe := NewObserveExternalOIDC(featureGatesWithOIDC, operatorclient.TargetNamespace, clock.RealClock{}, tt.options...)
+			actualConfig, errs := observe(listers, events.NewInMemoryRecorder("externaloidctest", clock.RealClock{}), nil)
+			if tt.expectErrors != (len(errs) > 0) {
+				t.Errorf("expected errors: %v; got %v", tt.expectErrors, errs)
+			}
+
+			if !equality.Semantic.DeepEqual(tt.expectedConfig, actualConfig) {
+				t.Errorf("unexpected config diff: %s", diff.Diff(tt.expectedConfig, actualConfig))
+			}
+		})
+	}
+}
+
 func TestValidateSourceConfigMap(t *testing.T) {
 
 	for _, tt := range []struct {
@@ -483,6 +1099,345 @@ func TestValidateSourceConfigMap(t *testing.T) {
 	}
 }
 
+func TestObserveExternalOIDCMissingOIDCClientsWarning(t *testing.T) {
+	cmIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	cmIndexer.Add(&baseSourceConfigMap)
+	cmIndexer.Add(&baseTargetConfigMap)
+	authIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+
+	listers := configobservation.Listers{
+		AuthConfigLister:     configlistersv1.NewAuthenticationLister(authIndexer),
+		ClusterVersionLister: configlistersv1.NewClusterVersionLister(cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})),
+		ConfigmapLister_:     corelistersv1.NewConfigMapLister(cmIndexer),
+		ResourceSync:         &mockResourceSyncer{t: t, synced: map[string]string{}},
+	}
+
+	c := externalOIDC{
+		featureGateAccessor: featureGatesWithOIDC,
+		clock:               clock.RealClock{},
+		targetNamespace:     operatorclient.TargetNamespace,
+		expectedOIDCClients: defaultExpectedOIDCClients,
+	}
+
+	for _, step := range []struct {
+		name          string
+		auth          *configv1.Authentication
+		expectWarning bool
+	}{
+		{name: "console client missing", auth: &authResourceWithOIDC, expectWarning: true},
+		{name: "console client still missing on resync", auth: &authResourceWithOIDC, expectWarning: false},
+		{name: "console client configured", auth: &authResourceWithOIDCClients, expectWarning: false},
+		{name: "console client removed again", auth: &authResourceWithOIDC, expectWarning: true},
+		{name: "switched to IntegratedOAuth", auth: &authResourceWithOAuth, expectWarning: false},
+		{name: "switched back to OIDC with console client still missing", auth: &authResourceWithOIDC, expectWarning: true},
+	} {
+		authIndexer.Update(step.auth)
+		eventRecorder := events.NewInMemoryRecorder("externaloidctest", clock.RealClock{})
+		if _, errs := c.ObserveExternalOIDC(listers, eventRecorder, baseConfig); len(errs) > 0 {
+			t.Fatalf("%s: unexpected errors: %v", step.name, errs)
+		}
+
+		warned := false
+		for _, event := range eventRecorder.Events() {
+			warned = warned || event.Type == corev1.EventTypeWarning
+		}
+		if warned != step.expectWarning {
+			t.Errorf("%s: expected warning: %v; got events: %v", step.name, step.expectWarning, eventRecorder.Events())
+		}
+	}
+}
+
+func TestObserveExternalOIDCDuplicateEvents(t *testing.T) {
+	cmIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	cmIndexer.Add(&issuerSourceConfigMap)
+	cmIndexer.Add(&issuerTargetConfigMap)
+	authIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	authIndexer.Add(&authResourceWithOIDCClients)
+
+	listers := configobservation.Listers{
+		AuthConfigLister:     configlistersv1.NewAuthenticationLister(authIndexer),
+		ClusterVersionLister: configlistersv1.NewClusterVersionLister(cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})),
+		ConfigmapLister_:     corelistersv1.NewConfigMapLister(cmIndexer),
+		ResourceSync:         &mockResourceSyncer{t: t, synced: map[string]string{}},
+	}
+
+	syncedTargetConfigMap := issuerSourceConfigMap.DeepCopy()
+	syncedTargetConfigMap.Namespace = operatorclient.TargetNamespace
+
+	observe := NewObserveExternalOIDC(featureGatesWithOIDC, operatorclient.TargetNamespace, clock.RealClock{})
+	for _, step := range []struct {
+		name           string
+		target         *corev1.ConfigMap
+		restart        bool
+		existingConfig map[string]interface{}
+		expectEvents   bool
+	}{
+		{name: "issuers changed", target: &issuerTargetConfigMap, existingConfig: baseConfig, expectEvents: true},
+		{name: "issuers still changing on resync", target: &issuerTargetConfigMap, existingConfig: baseConfig, expectEvents: false},
+		{name: "auth config synced", target: syncedTargetConfigMap, existingConfig: baseConfig, expectEvents: false},
+		{name: "operator restarted with identical config", target: syncedTargetConfigMap, restart: true, existingConfig: nil, expectEvents: false},
+		{name: "issuers changed back", target: &issuerTargetConfigMap, existingConfig: baseConfig, expectEvents: true},
+	} {
+		cmIndexer.Update(step.target)
+		if step.restart {
+			observe = NewObserveExternalOIDC(featureGatesWithOIDC, operatorclient.TargetNamespace, clock.RealClock{})
+		}
+
+		eventRecorder := events.NewInMemoryRecorder("externaloidctest", clock.RealClock{})
+		actualConfig, errs := observe(listers, eventRecorder, step.existingConfig)
+		if len(errs) > 0 {
+			t.Fatalf("%s: unexpected errors: %v", step.name, errs)
+		}
+
+		if !equality.Semantic.DeepEqual(baseConfig, actualConfig) {
+			t.Errorf("%s: unexpected config diff: %s", step.name, diff.Diff(baseConfig, actualConfig))
+		}
+
+		if recordedEvents := eventRecorder.Events(); step.expectEvents != (len(recordedEvents) > 0) {
+			t.Errorf("%s: expected events: %v; got %v", step.name, step.expectEvents, recordedEvents)
+		}
+	}
+}
+
+func TestEnabledOIDCComponents(t *testing.T) {
+	console := oidcComponent{name: "console", namespace: "openshift-console", capability: configv1.ClusterVersionCapabilityConsole}
+	cli := oidcComponent{name: "cli", namespace: "openshift-console"}
+
+	for _, tt := range []struct {
+		name           string
+		clusterVersion *configv1.ClusterVersion
+		expected       []oidcComponent
+	}{
+		{
+			name:           "cluster version not found",
+			clusterVersion: nil,
+			expected:       []oidcComponent{console, cli},
+		},
+		{
+			name:           "capability enabled",
+			clusterVersion: clusterVersionWithCapabilities(configv1.ClusterVersionCapabilityConsole),
+			expected:       []oidcComponent{console, cli},
+		},
+		{
+			name:           "capability disabled",
+			clusterVersion: clusterVersionWithCapabilities(configv1.ClusterVersionCapabilityBuild),
+			expected:       []oidcComponent{cli},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			cvIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			if tt.clusterVersion != nil {
+				cvIndexer.Add(tt.clusterVersion)
+			}
+			listers := configobservation.Listers{ClusterVersionLister: configlistersv1.NewClusterVersionLister(cvIndexer)}
+
+			actual, err := enabledOIDCComponents(listers, []oidcComponent{console, cli})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(tt.expected, actual) {
+				t.Errorf("expected components %v; got %v", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestMissingOIDCClients(t *testing.T) {
+	for _, tt := range []struct {
+		name            string
+		auth            *configv1.Authentication
+		components      []oidcComponent
+		expectedMissing []string
+	}{
+		{
+			name:            "no expected components",
+			auth:            &authResourceWithOIDC,
+			components:      nil,
+			expectedMissing: []string{},
+		},
+		{
+			name:            "no OIDC clients configured",
+			auth:            &authResourceWithOIDC,
+			components:      defaultExpectedOIDCClients,
+			expectedMissing: []string{"openshift-console/console"},
+		},
+		{
+			name:            "all expected OIDC clients configured",
+			auth:            &authResourceWithOIDCClients,
+			components:      defaultExpectedOIDCClients,
+			expectedMissing: []string{},
+		},
+		{
+			name: "component configured in a different namespace",
+			auth: &authResourceWithOIDCClients,
+			components: []oidcComponent{
+				{name: "console", namespace: "openshift-console"},
+				{name: "kube-apiserver", namespace: "openshift-kubeapiserver"},
+			},
+			expectedMissing: []string{"openshift-kubeapiserver/kube-apiserver"},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			missing := missingOIDCClients(tt.auth, tt.components)
+			if !equality.Semantic.DeepEqual(tt.expectedMissing, missing) {
+				t.Errorf("unexpected missing components: %s", diff.Diff(tt.expectedMissing, missing))
+			}
+		})
+	}
+}
+
+func TestRemovedIssuers(t *testing.T) {
+	for _, tt := range []struct {
+		name            string
+		oldAuthConfig   string
+		newAuthConfig   string
+		expectedRemoved []string
+	}{
+		{
+			name:            "issuer replaced",
+			oldAuthConfig:   issuerTargetConfigMap.Data["auth-config.json"],
+			newAuthConfig:   issuerSourceConfigMap.Data["auth-config.json"],
+			expectedRemoved: []string{"https://old.example.com"},
+		},
+		{
+			name:            "issuer added",
+			oldAuthConfig:   issuerTargetConfigMap.Data["auth-config.json"],
+			newAuthConfig:   `{"jwt":[{"issuer":{"url":"https://old.example.com"}},{"issuer":{"url":"https://new.example.com"}}]}`,
+			expectedRemoved: []string{},
+		},
+		{
+			name:            "audiences changed",
+			oldAuthConfig:   issuerTargetConfigMap.Data["auth-config.json"],
+			newAuthConfig:   `{"jwt":[{"issuer":{"url":"https://old.example.com","audiences":["other"]}}]}`,
+			expectedRemoved: []string{},
+		},
+		{
+			name:            "all issuers removed",
+			oldAuthConfig:   `{"jwt":[{"issuer":{"url":"https://b.example.com"}},{"issuer":{"url":"https://a.example.com"}}]}`,
+			newAuthConfig:   `{"jwt":[]}`,
+			expectedRemoved: []string{"https://a.example.com", "https://b.example.com"},
+		},
+		{
+			name:            "unparseable old auth config",
+			oldAuthConfig:   `{`,
+			newAuthConfig:   issuerSourceConfigMap.Data["auth-config.json"],
+			expectedRemoved: nil,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			removed := removedIssuers(tt.oldAuthConfig, tt.newAuthConfig)
+			if !equality.Semantic.DeepEqual(tt.expectedRemoved, removed) {
+				t.Errorf("unexpected removed issuers: %s", diff.Diff(tt.expectedRemoved, removed))
+			}
+		})
+	}
+}
+
+func TestIssuerSummary(t *testing.T) {
+	for _, tt := range []struct {
+		name       string
+		authConfig string
+		expected   string
+	}{
+		{
+			name:       "no jwt authenticators",
+			authConfig: `{"kind":"AuthenticationConfiguration","apiVersion":"apiserver.config.k8s.io/v1beta1"}`,
+			expected:   "[]",
+		},
+		{
+			name:       "single jwt authenticator",
+			authConfig: issuerSourceConfigMap.Data["auth-config.json"],
+			expected:   "[https://new.example.com (audiences: kube-apiserver)]",
+		},
+		{
+			name:       "multiple jwt authenticators",
+			authConfig: `{"jwt":[{"issuer":{"url":"https://a.example.com","audiences":["a","b"]}},{"issuer":{"url":"https://b.example.com"}}]}`,
+			expected:   "[https://a.example.com (audiences: a,b); https://b.example.com (audiences: )]",
+		},
+		{
+			name:       "unparseable auth config",
+			authConfig: `{`,
+			expected:   "<unparseable>",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := issuerSummary(tt.authConfig); actual != tt.expected {
+				t.Errorf("expected summary %q; got %q", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestValidateAuthConfig(t *testing.T) {
+	for _, tt := range []struct {
+		name              string
+		authConfig        string
+		disallowedIssuers []string
+		expectError       bool
+	}{
+		{
+			name:       "no jwt authenticators",
+			authConfig: baseSourceConfigMap.Data["auth-config.json"],
+		},
+		{
+			name:       "valid jwt authenticator",
+			authConfig: issuerSourceConfigMap.Data["auth-config.json"],
+		},
+		{
+			name:              "jwt issuer different from service account issuer",
+			authConfig:        issuerSourceConfigMap.Data["auth-config.json"],
+			disallowedIssuers: []string{"https://kubernetes.default.svc"},
+		},
+		{
+			name:              "jwt issuer matches service account issuer",
+			authConfig:        issuerSourceConfigMap.Data["auth-config.json"],
+			disallowedIssuers: []string{"https://kubernetes.default.svc", "https://new.example.com"},
+			expectError:       true,
+		},
+		{
+			name:        "unparseable auth config",
+			authConfig:  `{`,
+			expectError: true,
+		},
+		{
+			name:        "unknown kind",
+			authConfig:  `{"kind":"AuthorizationConfiguration","apiVersion":"apiserver.config.k8s.io/v1beta1"}`,
+			expectError: true,
+		},
+		{
+			name:        "missing username claim mapping",
+			authConfig:  rejectedSourceConfigMap.Data["auth-config.json"],
+			expectError: true,
+		},
+		{
+			name:        "duplicate issuers",
+			authConfig:  `{"kind":"AuthenticationConfiguration","apiVersion":"apiserver.config.k8s.io/v1beta1","jwt":[{"issuer":{"url":"https://a.example.com","audiences":["a"]},"claimMappings":{"username":{"claim":"sub","prefix":""}}},{"issuer":{"url":"https://a.example.com","audiences":["a"]},"claimMappings":{"username":{"claim":"sub","prefix":""}}}]}`,
+			expectError: true,
+		},
+		{
+			name:        "invalid CEL expression",
+			authConfig:  `{"kind":"AuthenticationConfiguration","apiVersion":"apiserver.config.k8s.io/v1beta1","jwt":[{"issuer":{"url":"https://a.example.com","audiences":["a"]},"claimMappings":{"username":{"expression":"claims.sub +"}}}]}`,
+			expectError: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateAuthConfig(tt.authConfig, tt.disallowedIssuers); tt.expectError != (err != nil) {
+				t.Errorf("expected error: %v; got: %v", tt.expectError, err)
+			}
+		})
+	}
+}
+
+func clusterVersionWithCapabilities(capabilities ...configv1.ClusterVersionCapability) *configv1.ClusterVersion {
+	return &configv1.ClusterVersion{
+		ObjectMeta: metav1.ObjectMeta{Name: "version"},
+		Status: configv1.ClusterVersionStatus{
+			Capabilities: configv1.ClusterVersionCapabilitiesStatus{EnabledCapabilities: capabilities},
+		},
+	}
+}
+
 func makeClosedChannel() chan struct{} {
 	ch := make(chan struct{})
 	close(ch)
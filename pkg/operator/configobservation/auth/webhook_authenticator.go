@@ -22,10 +22,15 @@ import (
 	"github.com/openshift/cluster-kube-apiserver-operator/pkg/operator/operatorclient"
 )
 
+const (
+	webhookTokenAuthenticatorArg        = "authentication-token-webhook-config-file"
+	webhookTokenAuthenticatorVersionArg = "authentication-token-webhook-version"
+)
+
 var (
-	webhookTokenAuthenticatorPath        = []string{"apiServerArguments", "authentication-token-webhook-config-file"}
+	webhookTokenAuthenticatorPath        = []string{"apiServerArguments", webhookTokenAuthenticatorArg}
 	webhookTokenAuthenticatorFile        = []interface{}{"/etc/kubernetes/static-pod-resources/secrets/webhook-authenticator/kubeConfig"}
-	webhookTokenAuthenticatorVersionPath = []string{"apiServerArguments", "authentication-token-webhook-version"}
+	webhookTokenAuthenticatorVersionPath = []string{"apiServerArguments", webhookTokenAuthenticatorVersionArg}
 	webhookTokenAuthenticatorVersion     = []interface{}{"v1"}
 )
 
@@ -201,8 +206,9 @@ func (o *webhookTokenAuthenticatorObserver) ObserveWebhookTokenAuthenticator(gen
 	if observedWebhookConfigured != existingWebhookConfigured {
 		recorder.Eventf(
 			"ObserveWebhookTokenAuthenticator",
-			"authentication-token webhook configuration status changed from %v to %v",
+			"authentication-token webhook configuration status changed from %v to %v; changed arguments: %v",
 			existingWebhookConfigured, observedWebhookConfigured,
+			configobservation.DiffAPIServerArguments(existingConfig, observedConfig, []string{webhookTokenAuthenticatorArg, webhookTokenAuthenticatorVersionArg}),
 		)
 	}
 
@@ -74,6 +74,7 @@ func TestObserveWebhookTokenAuthenticator(t *testing.T) {
 		webhookConfigured bool
 		expectErrs        bool
 		expectEvents      bool
+		expectedEvent     string
 		expectedSynced    map[string]string
 		expectedConfig    map[string]interface{}
 		gates             featuregates.FeatureGateAccess
@@ -156,7 +157,8 @@ func TestObserveWebhookTokenAuthenticator(t *testing.T) {
 			expectedSynced: map[string]string{
 				"secret/webhook-authenticator.openshift-kube-apiserver": "secret/config-secret.openshift-config",
 			},
-			expectEvents: true,
+			expectEvents:  true,
+			expectedEvent: "authentication-token webhook configuration status changed from false to true; changed arguments: [authentication-token-webhook-config-file authentication-token-webhook-version]",
 			gates: featuregates.NewHardcodedFeatureGateAccess(
 				[]configv1.FeatureGateName{},
 				[]configv1.FeatureGateName{features.FeatureGateExternalOIDCExternalClaimsSourcing},
@@ -388,6 +390,19 @@ func TestObserveWebhookTokenAuthenticator(t *testing.T) {
 				t.Errorf("expected events: %v, but got %v", tt.expectEvents, recordedEvents)
 			}
 
+			if len(tt.expectedEvent) > 0 {
+				var found bool
+				for _, event := range eventRecorder.Events() {
+					if event.Message == tt.expectedEvent {
+						found = true
+						break
+					}
+				}
+				if !found {
+					t.Errorf("expected event %q, but got %v", tt.expectedEvent, eventRecorder.Events())
+				}
+			}
+
 			if tt.expectErrs && len(errs) == 0 {
 				t.Error("Expected errors.")
 			}
@@ -0,0 +1,99 @@
+package configobservercontroller
+
+import (
+	"sync"
+	"time"
+
+	"github.com/openshift/library-go/pkg/operator/configobserver"
+	"github.com/openshift/library-go/pkg/operator/events"
+	"github.com/openshift/library-go/pkg/operator/resourcesynccontroller"
+
+	"k8s.io/component-base/metrics"
+	"k8s.io/component-base/metrics/legacyregistry"
+	"k8s.io/klog/v2"
+
+	"github.com/openshift/cluster-kube-apiserver-operator/pkg/operator/configobservation"
+)
+
+var (
+	registerMetrics sync.Once
+
+	observerErrorsCounter = metrics.NewCounterVec(&metrics.CounterOpts{
+		Name: "kube_apiserver_operator_configobserver_errors_total",
+		Help: "Counts config observations that returned errors, per observer.",
+	}, []string{"observer"})
+)
+
+// RegisterMetrics registers the metrics reported for the config observers.
+func RegisterMetrics() {
+	registerMetrics.Do(func() {
+		legacyregistry.MustRegister(observerErrorsCounter)
+	})
+}
+
+// instrumentedObserver is a config observer along with the name used to label its
+// metrics and log lines; the name must stay stable since alerts may refer to it.
+type instrumentedObserver struct {
+	name    string
+	observe configobserver.ObserveConfigFunc
+}
+
+// instrumentObservers wraps the given observers so that every observation returning
+// errors increments the errors counter of the respective observer. When tracing is
+// enabled, the duration, the number of resource syncs requested and the number of
+// errors of every observation get logged too.
+func instrumentObservers(tracing bool, observers []instrumentedObserver) []configobserver.ObserveConfigFunc {
+	wrapped := make([]configobserver.ObserveConfigFunc, 0, len(observers))
+	for _, observer := range observers {
+		name, observe := observer.name, observer.observe
+		wrapped = append(wrapped, func(listers configobserver.Listers, recorder events.Recorder, existingConfig map[string]interface{}) (map[string]interface{}, []error) {
+			var syncer *countingResourceSyncer
+			if tracing {
+				listers, syncer = withCountingResourceSyncer(listers)
+			}
+
+			start := time.Now()
+			observedConfig, errs := observe(listers, recorder, existingConfig)
+			if tracing {
+				klog.InfoS("Config observation finished", "observer", name, "duration", time.Since(start), "syncs", syncer.syncs, "errors", len(errs))
+			}
+			if len(errs) > 0 {
+				observerErrorsCounter.WithLabelValues(name).Inc()
+			}
+			return observedConfig, errs
+		})
+	}
+	return wrapped
+}
+
+// withCountingResourceSyncer returns a copy of the given listers whose resource syncer
+// counts the requested syncs. Listers of other types are returned unchanged, in which
+// case no syncs get counted.
+func withCountingResourceSyncer(listers configobserver.Listers) (configobserver.Listers, *countingResourceSyncer) {
+	l, ok := listers.(configobservation.Listers)
+	if !ok {
+		return listers, &countingResourceSyncer{}
+	}
+
+	syncer := &countingResourceSyncer{ResourceSyncer: l.ResourceSync}
+	l.ResourceSync = syncer
+	return l, syncer
+}
+
+// countingResourceSyncer counts the sync rules requested through it. Requesting a
+// sync only registers it with the resource sync controller, which copies the
+// resource asynchronously.
+type countingResourceSyncer struct {
+	resourcesynccontroller.ResourceSyncer
+	syncs int
+}
+
+func (s *countingResourceSyncer) SyncConfigMap(destination, source resourcesynccontroller.ResourceLocation) error {
+	s.syncs++
+	return s.ResourceSyncer.SyncConfigMap(destination, source)
+}
+
+func (s *countingResourceSyncer) SyncSecret(destination, source resourcesynccontroller.ResourceLocation) error {
+	s.syncs++
+	return s.ResourceSyncer.SyncSecret(destination, source)
+}
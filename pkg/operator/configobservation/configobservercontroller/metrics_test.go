@@ -0,0 +1,97 @@
+package configobservercontroller
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openshift/library-go/pkg/operator/configobserver"
+	"github.com/openshift/library-go/pkg/operator/events"
+	"github.com/openshift/library-go/pkg/operator/resourcesynccontroller"
+
+	"k8s.io/component-base/metrics/testutil"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/openshift/cluster-kube-apiserver-operator/pkg/operator/configobservation"
+)
+
+func failingObserver(_ configobserver.Listers, _ events.Recorder, existingConfig map[string]interface{}) (map[string]interface{}, []error) {
+	return existingConfig, []error{fmt.Errorf("observer error")}
+}
+
+func succeedingObserver(_ configobserver.Listers, _ events.Recorder, existingConfig map[string]interface{}) (map[string]interface{}, []error) {
+	return existingConfig, nil
+}
+
+func TestInstrumentObservers(t *testing.T) {
+	RegisterMetrics()
+	observerErrorsCounter.Reset()
+
+	failingClosure := func(listers configobserver.Listers, recorder events.Recorder, existingConfig map[string]interface{}) (map[string]interface{}, []error) {
+		return failingObserver(listers, recorder, existingConfig)
+	}
+
+	eventRecorder := events.NewInMemoryRecorder("TestInstrumentObservers", clocktesting.NewFakePassiveClock(time.Now()))
+	for _, tracing := range []bool{false, true} {
+		observers := instrumentObservers(tracing, []instrumentedObserver{
+			{"Failing", failingObserver},
+			{"Succeeding", succeedingObserver},
+			{"FailingClosure", failingClosure},
+		})
+		for _, observer := range observers {
+			observer(nil, eventRecorder, map[string]interface{}{})
+		}
+	}
+
+	expected := `
+# HELP kube_apiserver_operator_configobserver_errors_total [ALPHA] Counts config observations that returned errors, per observer.
+# TYPE kube_apiserver_operator_configobserver_errors_total counter
+kube_apiserver_operator_configobserver_errors_total{observer="Failing"} 2
+kube_apiserver_operator_configobserver_errors_total{observer="FailingClosure"} 2
+`
+	if err := testutil.CollectAndCompare(observerErrorsCounter, strings.NewReader(expected), "kube_apiserver_operator_configobserver_errors_total"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInstrumentObserversTracingSyncs(t *testing.T) {
+	resourceSyncer := &fakeResourceSyncer{}
+	listers := configobservation.Listers{ResourceSync: resourceSyncer}
+
+	var syncer *countingResourceSyncer
+	observer := func(listers configobserver.Listers, _ events.Recorder, existingConfig map[string]interface{}) (map[string]interface{}, []error) {
+		// the observer must still be able to use the listers of this operator
+		syncer = listers.(configobservation.Listers).ResourceSync.(*countingResourceSyncer)
+		listers.ResourceSyncer().SyncConfigMap(resourcesynccontroller.ResourceLocation{}, resourcesynccontroller.ResourceLocation{})
+		listers.ResourceSyncer().SyncSecret(resourcesynccontroller.ResourceLocation{}, resourcesynccontroller.ResourceLocation{})
+		return existingConfig, nil
+	}
+
+	eventRecorder := events.NewInMemoryRecorder("TestInstrumentObserversTracingSyncs", clocktesting.NewFakePassiveClock(time.Now()))
+	for _, wrapped := range instrumentObservers(true, []instrumentedObserver{{"Syncing", observer}}) {
+		wrapped(listers, eventRecorder, map[string]interface{}{})
+	}
+
+	if syncer.syncs != 2 {
+		t.Errorf("expected 2 counted syncs; got %d", syncer.syncs)
+	}
+
+	if resourceSyncer.syncs != 2 {
+		t.Errorf("expected 2 syncs to reach the resource syncer; got %d", resourceSyncer.syncs)
+	}
+}
+
+type fakeResourceSyncer struct {
+	syncs int
+}
+
+func (s *fakeResourceSyncer) SyncConfigMap(_, _ resourcesynccontroller.ResourceLocation) error {
+	s.syncs++
+	return nil
+}
+
+func (s *fakeResourceSyncer) SyncSecret(_, _ resourcesynccontroller.ResourceLocation) error {
+	s.syncs++
+	return nil
+}
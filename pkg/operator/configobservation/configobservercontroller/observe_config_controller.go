@@ -1,9 +1,12 @@
 package configobservercontroller
 
 import (
+	"os"
+
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/clock"
 
 	configv1 "github.com/openshift/api/config/v1"
 	configinformers "github.com/openshift/client-go/config/informers/externalversions"
@@ -61,6 +64,7 @@ func NewConfigObserver(operatorClient v1helpers.StaticPodOperatorClient, kubeInf
 		configInformer.Config().V1().Images().Informer(),
 		configInformer.Config().V1().Infrastructures().Informer(),
 		configInformer.Config().V1().Authentications().Informer(),
+		configInformer.Config().V1().ClusterVersions().Informer(),
 		configInformer.Config().V1().APIServers().Informer(),
 		configInformer.Config().V1().Networks().Informer(),
 		configInformer.Config().V1().Nodes().Informer(),
@@ -72,6 +76,9 @@ func NewConfigObserver(operatorClient v1helpers.StaticPodOperatorClient, kubeInf
 		infomers = append(infomers, kubeInformersForNamespaces.InformersFor(ns).Core().V1().ConfigMaps().Informer())
 	}
 
+	// log the duration of every config observation, for debugging slow observer loops
+	tracing := os.Getenv("OPENSHIFT_CONFIG_OBSERVER_TRACING") == "true"
+
 	c := &ConfigObserver{
 		Controller: configobserver.NewConfigObserver(
 			"kube-apiserver",
@@ -80,6 +87,7 @@ func NewConfigObserver(operatorClient v1helpers.StaticPodOperatorClient, kubeInf
 			configobservation.Listers{
 				APIServerLister_:      configInformer.Config().V1().APIServers().Lister(),
 				AuthConfigLister:      configInformer.Config().V1().Authentications().Lister(),
+				ClusterVersionLister:  configInformer.Config().V1().ClusterVersions().Lister(),
 				FeatureGateLister_:    configInformer.Config().V1().FeatureGates().Lister(),
 				ImageConfigLister:     configInformer.Config().V1().Images().Lister(),
 				InfrastructureLister_: configInformer.Config().V1().Infrastructures().Lister(),
@@ -104,6 +112,7 @@ func NewConfigObserver(operatorClient v1helpers.StaticPodOperatorClient, kubeInf
 
 					configInformer.Config().V1().APIServers().Informer().HasSynced,
 					configInformer.Config().V1().Authentications().Informer().HasSynced,
+					configInformer.Config().V1().ClusterVersions().Informer().HasSynced,
 					configInformer.Config().V1().FeatureGates().Informer().HasSynced,
 					configInformer.Config().V1().Images().Informer().HasSynced,
 					configInformer.Config().V1().Infrastructures().Informer().HasSynced,
@@ -115,60 +124,62 @@ func NewConfigObserver(operatorClient v1helpers.StaticPodOperatorClient, kubeInf
 				),
 			},
 			infomers,
-			// We are disabling this because it doesn't work today and customers aren't going to be able to get the kube service network options right.
-			// Customers may only use SNI.  I'm leaving this code in case we ever come up with a way to make an SNI-like thing based on IPs.
-			//apiserver.ObserveDefaultUserServingCertificate,
-			apiserver.ObserveNamedCertificates,
-			apiserver.ObserveUserClientCABundle,
-			apiserver.ObserveAdditionalCORSAllowedOrigins,
-			apiserver.ObserveShutdownDelayDuration,
-			apiserver.ObserveGracefulTerminationDuration,
-			apiserver.ObserveSendRetryAfterWhileNotReadyOnce,
-			apiserver.ObserveGoawayChance,
-			apiserver.ObserveAdmissionPlugins,
-			apiserver.NewObserveEventTTL(featureGateAccessor),
-			libgoapiserver.ObserveTLSSecurityProfile,
-			auth.NewObserveAuthMetadata(featureGateAccessor),
-			auth.ObserveServiceAccountIssuer,
-			auth.NewObserveWebhookTokenAuthenticator(featureGateAccessor),
-			auth.NewObserveExternalOIDC(featureGateAccessor),
-			auth.NewObservePodSecurityAdmissionEnforcementFunc(featureGateAccessor),
-			encryption.NewEncryptionConfigObserver(
-				operatorclient.TargetNamespace,
-				// static path at which we expect to find the encryption config secret
-				"/etc/kubernetes/static-pod-resources/secrets/encryption-config/encryption-config",
-			),
-			etcdendpoints.ObserveStorageURLs,
-			cloudprovider.NewCloudProviderObserver(
-				"openshift-kube-apiserver", true,
-			),
-			apienablement.NewFeatureGateObserverWithRuntimeConfig(
-				nil,
-				FeatureBlacklist,
-				featureGateAccessor,
-				groupVersionsByFeatureGate,
-			),
-			network.ObserveRestrictedCIDRs,
-			network.ObserveServicesSubnet,
-			network.ObserveExternalIPPolicy,
-			network.ObserveServicesNodePortRange,
-			nodeobserver.NewLatencyProfileObserver(
-				node.LatencyConfigs,
-				[]nodeobserver.ShouldSuppressConfigUpdatesFunc{
-					nodeobserver.NewSuppressConfigUpdateUntilSameProfileFunc(
-						operatorClient,
-						kubeInformersForNamespaces.ConfigMapLister().ConfigMaps(operatorclient.TargetNamespace),
-						node.LatencyConfigs,
-					),
-				},
-			),
-			node.NewMinimumKubeletVersionObserver(featureGateAccessor),
-			node.NewAuthorizationModeObserver(featureGateAccessor),
-			proxy.NewProxyObserveFunc([]string{"targetconfigcontroller", "proxy"}),
-			images.ObserveInternalRegistryHostname,
-			images.ObserveExternalRegistryHostnames,
-			images.ObserveAllowedRegistriesForImport,
-			scheduler.ObserveDefaultNodeSelector,
+			instrumentObservers(tracing, []instrumentedObserver{
+				// We are disabling this because it doesn't work today and customers aren't going to be able to get the kube service network options right.
+				// Customers may only use SNI.  I'm leaving this code in case we ever come up with a way to make an SNI-like thing based on IPs.
+				//{"DefaultUserServingCertificate", apiserver.ObserveDefaultUserServingCertificate},
+				{"NamedCertificates", apiserver.ObserveNamedCertificates},
+				{"UserClientCABundle", apiserver.ObserveUserClientCABundle},
+				{"AdditionalCORSAllowedOrigins", apiserver.ObserveAdditionalCORSAllowedOrigins},
+				{"ShutdownDelayDuration", apiserver.ObserveShutdownDelayDuration},
+				{"GracefulTerminationDuration", apiserver.ObserveGracefulTerminationDuration},
+				{"SendRetryAfterWhileNotReadyOnce", apiserver.ObserveSendRetryAfterWhileNotReadyOnce},
+				{"GoawayChance", apiserver.ObserveGoawayChance},
+				{"AdmissionPlugins", apiserver.ObserveAdmissionPlugins},
+				{"EventTTL", apiserver.NewObserveEventTTL(featureGateAccessor)},
+				{"TLSSecurityProfile", libgoapiserver.ObserveTLSSecurityProfile},
+				{"AuthMetadata", auth.NewObserveAuthMetadata(featureGateAccessor)},
+				{"ServiceAccountIssuer", auth.ObserveServiceAccountIssuer},
+				{"WebhookTokenAuthenticator", auth.NewObserveWebhookTokenAuthenticator(featureGateAccessor)},
+				{"ExternalOIDC", auth.NewObserveExternalOIDC(featureGateAccessor, operatorclient.TargetNamespace, clock.RealClock{})},
+				{"PodSecurityAdmissionEnforcement", auth.NewObservePodSecurityAdmissionEnforcementFunc(featureGateAccessor)},
+				{"EncryptionConfig", encryption.NewEncryptionConfigObserver(
+					operatorclient.TargetNamespace,
+					// static path at which we expect to find the encryption config secret
+					"/etc/kubernetes/static-pod-resources/secrets/encryption-config/encryption-config",
+				)},
+				{"StorageURLs", etcdendpoints.ObserveStorageURLs},
+				{"CloudProvider", cloudprovider.NewCloudProviderObserver(
+					"openshift-kube-apiserver", true,
+				)},
+				{"FeatureGates", apienablement.NewFeatureGateObserverWithRuntimeConfig(
+					nil,
+					FeatureBlacklist,
+					featureGateAccessor,
+					groupVersionsByFeatureGate,
+				)},
+				{"RestrictedCIDRs", network.ObserveRestrictedCIDRs},
+				{"ServicesSubnet", network.ObserveServicesSubnet},
+				{"ExternalIPPolicy", network.ObserveExternalIPPolicy},
+				{"ServicesNodePortRange", network.ObserveServicesNodePortRange},
+				{"LatencyProfile", nodeobserver.NewLatencyProfileObserver(
+					node.LatencyConfigs,
+					[]nodeobserver.ShouldSuppressConfigUpdatesFunc{
+						nodeobserver.NewSuppressConfigUpdateUntilSameProfileFunc(
+							operatorClient,
+							kubeInformersForNamespaces.ConfigMapLister().ConfigMaps(operatorclient.TargetNamespace),
+							node.LatencyConfigs,
+						),
+					},
+				)},
+				{"MinimumKubeletVersion", node.NewMinimumKubeletVersionObserver(featureGateAccessor)},
+				{"AuthorizationMode", node.NewAuthorizationModeObserver(featureGateAccessor)},
+				{"Proxy", proxy.NewProxyObserveFunc([]string{"targetconfigcontroller", "proxy"})},
+				{"InternalRegistryHostname", images.ObserveInternalRegistryHostname},
+				{"ExternalRegistryHostnames", images.ObserveExternalRegistryHostnames},
+				{"AllowedRegistriesForImport", images.ObserveAllowedRegistriesForImport},
+				{"DefaultNodeSelector", scheduler.ObserveDefaultNodeSelector},
+			})...,
 		),
 	}
 
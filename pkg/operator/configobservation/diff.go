@@ -0,0 +1,21 @@
+package configobservation
+
+import (
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// DiffAPIServerArguments returns those of the given apiServerArguments keys whose
+// values differ between the old and the new config, in the order they were given.
+// Arguments missing from one of the configs are considered changed as well.
+func DiffAPIServerArguments(oldConfig, newConfig map[string]interface{}, args []string) []string {
+	var changed []string
+	for _, arg := range args {
+		oldValue, _, _ := unstructured.NestedFieldNoCopy(oldConfig, "apiServerArguments", arg)
+		newValue, _, _ := unstructured.NestedFieldNoCopy(newConfig, "apiServerArguments", arg)
+		if !equality.Semantic.DeepEqual(oldValue, newValue) {
+			changed = append(changed, arg)
+		}
+	}
+	return changed
+}
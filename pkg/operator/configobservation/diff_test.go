@@ -0,0 +1,69 @@
+package configobservation
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiffAPIServerArguments(t *testing.T) {
+	args := []string{"authentication-config", "authentication-token-webhook-config-file", "authentication-token-webhook-version"}
+
+	for _, tt := range []struct {
+		name      string
+		oldConfig map[string]interface{}
+		newConfig map[string]interface{}
+		expected  []string
+	}{
+		{
+			name:      "both empty",
+			oldConfig: nil,
+			newConfig: map[string]interface{}{},
+			expected:  nil,
+		},
+		{
+			name:      "unchanged",
+			oldConfig: apiServerArguments("authentication-token-webhook-config-file", "/path/kubeConfig", "authentication-token-webhook-version", "v1"),
+			newConfig: apiServerArguments("authentication-token-webhook-config-file", "/path/kubeConfig", "authentication-token-webhook-version", "v1"),
+			expected:  nil,
+		},
+		{
+			name:      "added",
+			oldConfig: apiServerArguments("authentication-token-webhook-config-file", "/path/kubeConfig"),
+			newConfig: apiServerArguments("authentication-token-webhook-config-file", "/path/kubeConfig", "authentication-token-webhook-version", "v1"),
+			expected:  []string{"authentication-token-webhook-version"},
+		},
+		{
+			name:      "removed",
+			oldConfig: apiServerArguments("authentication-token-webhook-config-file", "/path/kubeConfig", "authentication-token-webhook-version", "v1"),
+			newConfig: nil,
+			expected:  []string{"authentication-token-webhook-config-file", "authentication-token-webhook-version"},
+		},
+		{
+			name:      "modified",
+			oldConfig: apiServerArguments("authentication-token-webhook-version", "v1beta1"),
+			newConfig: apiServerArguments("authentication-token-webhook-version", "v1"),
+			expected:  []string{"authentication-token-webhook-version"},
+		},
+		{
+			name:      "unrelated arguments are ignored",
+			oldConfig: apiServerArguments("authentication-config", "/path/auth-config.json", "goaway-chance", "0"),
+			newConfig: apiServerArguments("authentication-config", "/path/auth-config.json", "goaway-chance", "0.001"),
+			expected:  nil,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := DiffAPIServerArguments(tt.oldConfig, tt.newConfig, args); !reflect.DeepEqual(tt.expected, actual) {
+				t.Errorf("expected changed arguments %v; got %v", tt.expected, actual)
+			}
+		})
+	}
+}
+
+// apiServerArguments builds a config with the given argument name/value pairs.
+func apiServerArguments(nameValues ...string) map[string]interface{} {
+	args := map[string]interface{}{}
+	for i := 0; i < len(nameValues); i += 2 {
+		args[nameValues[i]] = []interface{}{nameValues[i+1]}
+	}
+	return map[string]interface{}{"apiServerArguments": args}
+}
@@ -17,6 +17,7 @@ var _ libgoetcd.ConfigMapLister = Listers{}
 type Listers struct {
 	APIServerLister_      configlistersv1.APIServerLister
 	AuthConfigLister      configlistersv1.AuthenticationLister
+	ClusterVersionLister  configlistersv1.ClusterVersionLister
 	FeatureGateLister_    configlistersv1.FeatureGateLister
 	InfrastructureLister_ configlistersv1.InfrastructureLister
 	ImageConfigLister     configlistersv1.ImageLister
@@ -517,6 +517,10 @@ func RunOperator(ctx context.Context, controllerContext *controllercmd.Controlle
 	// register termination metrics
 	terminationobserver.RegisterMetrics()
 
+	// register config observer metrics
+	configobservercontroller.RegisterMetrics()
+	auth.RegisterExternalOIDCMetrics()
+
 	// register config metrics
 	configmetrics.Register(configInformers)
 